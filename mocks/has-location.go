@@ -0,0 +1,101 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"sync"
+	timea "time"
+
+	"github.com/bborbe/time"
+)
+
+type HasLocation struct {
+	LocationStub        func() *timea.Location
+	locationMutex       sync.RWMutex
+	locationArgsForCall []struct {
+	}
+	locationReturns struct {
+		result1 *timea.Location
+	}
+	locationReturnsOnCall map[int]struct {
+		result1 *timea.Location
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *HasLocation) Location() *timea.Location {
+	fake.locationMutex.Lock()
+	ret, specificReturn := fake.locationReturnsOnCall[len(fake.locationArgsForCall)]
+	fake.locationArgsForCall = append(fake.locationArgsForCall, struct {
+	}{})
+	stub := fake.LocationStub
+	fakeReturns := fake.locationReturns
+	fake.recordInvocation("Location", []interface{}{})
+	fake.locationMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *HasLocation) LocationCallCount() int {
+	fake.locationMutex.RLock()
+	defer fake.locationMutex.RUnlock()
+	return len(fake.locationArgsForCall)
+}
+
+func (fake *HasLocation) LocationCalls(stub func() *timea.Location) {
+	fake.locationMutex.Lock()
+	defer fake.locationMutex.Unlock()
+	fake.LocationStub = stub
+}
+
+func (fake *HasLocation) LocationReturns(result1 *timea.Location) {
+	fake.locationMutex.Lock()
+	defer fake.locationMutex.Unlock()
+	fake.LocationStub = nil
+	fake.locationReturns = struct {
+		result1 *timea.Location
+	}{result1}
+}
+
+func (fake *HasLocation) LocationReturnsOnCall(i int, result1 *timea.Location) {
+	fake.locationMutex.Lock()
+	defer fake.locationMutex.Unlock()
+	fake.LocationStub = nil
+	if fake.locationReturnsOnCall == nil {
+		fake.locationReturnsOnCall = make(map[int]struct {
+			result1 *timea.Location
+		})
+	}
+	fake.locationReturnsOnCall[i] = struct {
+		result1 *timea.Location
+	}{result1}
+}
+
+func (fake *HasLocation) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *HasLocation) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ time.HasLocation = new(HasLocation)
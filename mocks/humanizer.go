@@ -0,0 +1,109 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/bborbe/time"
+)
+
+type Humanizer struct {
+	HumanizeStub        func(time.HasTime) string
+	humanizeMutex       sync.RWMutex
+	humanizeArgsForCall []struct {
+		arg1 time.HasTime
+	}
+	humanizeReturns struct {
+		result1 string
+	}
+	humanizeReturnsOnCall map[int]struct {
+		result1 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *Humanizer) Humanize(arg1 time.HasTime) string {
+	fake.humanizeMutex.Lock()
+	ret, specificReturn := fake.humanizeReturnsOnCall[len(fake.humanizeArgsForCall)]
+	fake.humanizeArgsForCall = append(fake.humanizeArgsForCall, struct {
+		arg1 time.HasTime
+	}{arg1})
+	stub := fake.HumanizeStub
+	fakeReturns := fake.humanizeReturns
+	fake.recordInvocation("Humanize", []interface{}{arg1})
+	fake.humanizeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Humanizer) HumanizeCallCount() int {
+	fake.humanizeMutex.RLock()
+	defer fake.humanizeMutex.RUnlock()
+	return len(fake.humanizeArgsForCall)
+}
+
+func (fake *Humanizer) HumanizeCalls(stub func(time.HasTime) string) {
+	fake.humanizeMutex.Lock()
+	defer fake.humanizeMutex.Unlock()
+	fake.HumanizeStub = stub
+}
+
+func (fake *Humanizer) HumanizeArgsForCall(i int) time.HasTime {
+	fake.humanizeMutex.RLock()
+	defer fake.humanizeMutex.RUnlock()
+	argsForCall := fake.humanizeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Humanizer) HumanizeReturns(result1 string) {
+	fake.humanizeMutex.Lock()
+	defer fake.humanizeMutex.Unlock()
+	fake.HumanizeStub = nil
+	fake.humanizeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *Humanizer) HumanizeReturnsOnCall(i int, result1 string) {
+	fake.humanizeMutex.Lock()
+	defer fake.humanizeMutex.Unlock()
+	fake.HumanizeStub = nil
+	if fake.humanizeReturnsOnCall == nil {
+		fake.humanizeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.humanizeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *Humanizer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *Humanizer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ time.Humanizer = new(Humanizer)
@@ -0,0 +1,241 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"sync"
+	timea "time"
+
+	"github.com/bborbe/time"
+)
+
+type LocationSource struct {
+	KeyStub        func() string
+	keyMutex       sync.RWMutex
+	keyArgsForCall []struct {
+	}
+	keyReturns struct {
+		result1 string
+	}
+	keyReturnsOnCall map[int]struct {
+		result1 string
+	}
+	LoadLocationStub        func(string) (*timea.Location, error)
+	loadLocationMutex       sync.RWMutex
+	loadLocationArgsForCall []struct {
+		arg1 string
+	}
+	loadLocationReturns struct {
+		result1 *timea.Location
+		result2 error
+	}
+	loadLocationReturnsOnCall map[int]struct {
+		result1 *timea.Location
+		result2 error
+	}
+	VersionStub        func() string
+	versionMutex       sync.RWMutex
+	versionArgsForCall []struct {
+	}
+	versionReturns struct {
+		result1 string
+	}
+	versionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *LocationSource) Key() string {
+	fake.keyMutex.Lock()
+	ret, specificReturn := fake.keyReturnsOnCall[len(fake.keyArgsForCall)]
+	fake.keyArgsForCall = append(fake.keyArgsForCall, struct {
+	}{})
+	stub := fake.KeyStub
+	fakeReturns := fake.keyReturns
+	fake.recordInvocation("Key", []interface{}{})
+	fake.keyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *LocationSource) KeyCallCount() int {
+	fake.keyMutex.RLock()
+	defer fake.keyMutex.RUnlock()
+	return len(fake.keyArgsForCall)
+}
+
+func (fake *LocationSource) KeyCalls(stub func() string) {
+	fake.keyMutex.Lock()
+	defer fake.keyMutex.Unlock()
+	fake.KeyStub = stub
+}
+
+func (fake *LocationSource) KeyReturns(result1 string) {
+	fake.keyMutex.Lock()
+	defer fake.keyMutex.Unlock()
+	fake.KeyStub = nil
+	fake.keyReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *LocationSource) KeyReturnsOnCall(i int, result1 string) {
+	fake.keyMutex.Lock()
+	defer fake.keyMutex.Unlock()
+	fake.KeyStub = nil
+	if fake.keyReturnsOnCall == nil {
+		fake.keyReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.keyReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *LocationSource) LoadLocation(arg1 string) (*timea.Location, error) {
+	fake.loadLocationMutex.Lock()
+	ret, specificReturn := fake.loadLocationReturnsOnCall[len(fake.loadLocationArgsForCall)]
+	fake.loadLocationArgsForCall = append(fake.loadLocationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.LoadLocationStub
+	fakeReturns := fake.loadLocationReturns
+	fake.recordInvocation("LoadLocation", []interface{}{arg1})
+	fake.loadLocationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *LocationSource) LoadLocationCallCount() int {
+	fake.loadLocationMutex.RLock()
+	defer fake.loadLocationMutex.RUnlock()
+	return len(fake.loadLocationArgsForCall)
+}
+
+func (fake *LocationSource) LoadLocationCalls(stub func(string) (*timea.Location, error)) {
+	fake.loadLocationMutex.Lock()
+	defer fake.loadLocationMutex.Unlock()
+	fake.LoadLocationStub = stub
+}
+
+func (fake *LocationSource) LoadLocationArgsForCall(i int) string {
+	fake.loadLocationMutex.RLock()
+	defer fake.loadLocationMutex.RUnlock()
+	argsForCall := fake.loadLocationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *LocationSource) LoadLocationReturns(result1 *timea.Location, result2 error) {
+	fake.loadLocationMutex.Lock()
+	defer fake.loadLocationMutex.Unlock()
+	fake.LoadLocationStub = nil
+	fake.loadLocationReturns = struct {
+		result1 *timea.Location
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *LocationSource) LoadLocationReturnsOnCall(i int, result1 *timea.Location, result2 error) {
+	fake.loadLocationMutex.Lock()
+	defer fake.loadLocationMutex.Unlock()
+	fake.LoadLocationStub = nil
+	if fake.loadLocationReturnsOnCall == nil {
+		fake.loadLocationReturnsOnCall = make(map[int]struct {
+			result1 *timea.Location
+			result2 error
+		})
+	}
+	fake.loadLocationReturnsOnCall[i] = struct {
+		result1 *timea.Location
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *LocationSource) Version() string {
+	fake.versionMutex.Lock()
+	ret, specificReturn := fake.versionReturnsOnCall[len(fake.versionArgsForCall)]
+	fake.versionArgsForCall = append(fake.versionArgsForCall, struct {
+	}{})
+	stub := fake.VersionStub
+	fakeReturns := fake.versionReturns
+	fake.recordInvocation("Version", []interface{}{})
+	fake.versionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *LocationSource) VersionCallCount() int {
+	fake.versionMutex.RLock()
+	defer fake.versionMutex.RUnlock()
+	return len(fake.versionArgsForCall)
+}
+
+func (fake *LocationSource) VersionCalls(stub func() string) {
+	fake.versionMutex.Lock()
+	defer fake.versionMutex.Unlock()
+	fake.VersionStub = stub
+}
+
+func (fake *LocationSource) VersionReturns(result1 string) {
+	fake.versionMutex.Lock()
+	defer fake.versionMutex.Unlock()
+	fake.VersionStub = nil
+	fake.versionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *LocationSource) VersionReturnsOnCall(i int, result1 string) {
+	fake.versionMutex.Lock()
+	defer fake.versionMutex.Unlock()
+	fake.VersionStub = nil
+	if fake.versionReturnsOnCall == nil {
+		fake.versionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.versionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *LocationSource) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *LocationSource) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ time.LocationSource = new(LocationSource)
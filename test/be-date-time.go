@@ -0,0 +1,24 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package test
+
+import (
+	"time"
+
+	"github.com/onsi/gomega/types"
+)
+
+// BeDateTime succeeds if actual is the same instant as expected, independent of location and type.
+// Actual and expected can be time.Time, DateTime, Date, UnixTime, any HasTime,
+// pointers to them or strings like "2024-03-05T10:00:00Z".
+func BeDateTime(expected interface{}) types.GomegaMatcher {
+	return &timeMatcher{
+		expected:    expected,
+		description: "be the same instant as",
+		match: func(actual, expected time.Time) bool {
+			return actual.Equal(expected)
+		},
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package test
+
+import (
+	"time"
+
+	"github.com/onsi/gomega/types"
+
+	libtime "github.com/bborbe/time"
+)
+
+// BeSameDay succeeds if actual is on the calendar day of expected in the location of expected.
+// It accepts the same types as BeDateTime.
+func BeSameDay(expected interface{}) types.GomegaMatcher {
+	return &timeMatcher{
+		expected:    expected,
+		description: "be on the same day as",
+		match: func(actual, expected time.Time) bool {
+			return libtime.HasEqualDate(actual.In(expected.Location()), expected)
+		},
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package test
+
+import (
+	"time"
+
+	"github.com/onsi/gomega/types"
+
+	libtime "github.com/bborbe/time"
+)
+
+// BeWithin succeeds if actual is at most tolerance before or after expected, see libtime.EqualWithin.
+// It accepts the same types as BeDateTime.
+func BeWithin(expected interface{}, tolerance libtime.Duration) types.GomegaMatcher {
+	return &timeMatcher{
+		expected:    expected,
+		description: "be within " + tolerance.String() + " of",
+		match: func(actual, expected time.Time) bool {
+			return libtime.EqualWithin(
+				libtime.DateTime(actual),
+				libtime.DateTime(expected),
+				tolerance,
+			)
+		},
+	}
+}
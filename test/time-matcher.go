@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/onsi/gomega/format"
+
+	libtime "github.com/bborbe/time"
+)
+
+// timeMatcher compares times of any type supported by asTime.
+type timeMatcher struct {
+	expected    interface{}
+	description string
+	match       func(actual, expected time.Time) bool
+}
+
+func (m *timeMatcher) Match(actual interface{}) (bool, error) {
+	actualTime, err := asTime(actual)
+	if err != nil {
+		return false, fmt.Errorf("actual: %w", err)
+	}
+	expectedTime, err := asTime(m.expected)
+	if err != nil {
+		return false, fmt.Errorf("expected: %w", err)
+	}
+	return m.match(actualTime, expectedTime), nil
+}
+
+func (m *timeMatcher) FailureMessage(actual interface{}) string {
+	return format.Message(formatTime(actual), "to "+m.description, formatTime(m.expected))
+}
+
+func (m *timeMatcher) NegatedFailureMessage(actual interface{}) string {
+	return format.Message(formatTime(actual), "not to "+m.description, formatTime(m.expected))
+}
+
+// asTime converts time.Time, libtime.HasTime like DateTime, Date or UnixTime, pointers to them
+// and strings parsed with libtime.ParseTime.
+func asTime(value interface{}) (time.Time, error) {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Pointer && v.IsNil() {
+		return time.Time{}, fmt.Errorf("nil %T", value)
+	}
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case *time.Time:
+		return *v, nil
+	case libtime.HasTime:
+		return v.Time(), nil
+	case string:
+		result, err := libtime.ParseTime(context.Background(), v)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return *result, nil
+	default:
+		return time.Time{}, fmt.Errorf("%T is no time", value)
+	}
+}
+
+// formatTime returns value as RFC 3339 time followed by its type, or value itself if it is no time.
+func formatTime(value interface{}) interface{} {
+	t, err := asTime(value)
+	if err != nil {
+		return value
+	}
+	return fmt.Sprintf("%s (%T)", t.Format(time.RFC3339Nano), value)
+}
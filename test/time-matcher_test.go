@@ -0,0 +1,57 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package test_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+	"github.com/bborbe/time/test"
+)
+
+var _ = Describe("Time matchers", func() {
+	var now time.Time
+	BeforeEach(func() {
+		now = time.Date(2024, time.March, 5, 10, 0, 0, 123456789, time.UTC)
+	})
+	It("BeDateTime matches the same instant across types", func() {
+		Expect(libtime.DateTime(now)).To(test.BeDateTime(now))
+		Expect(now).To(test.BeDateTime(libtime.DateTime(now).Ptr()))
+		Expect(libtime.UnixTime(now.Truncate(time.Second))).To(test.BeDateTime("2024-03-05T10:00:00Z"))
+		Expect(now.In(time.FixedZone("x", 3600))).To(test.BeDateTime(now))
+		Expect(libtime.DateTime(now)).NotTo(test.BeDateTime(now.Add(time.Nanosecond)))
+	})
+	It("BeSameDay compares calendar days", func() {
+		Expect(libtime.DateTime(now)).To(test.BeSameDay(libtime.Date(now)))
+		Expect(now).To(test.BeSameDay("2024-03-05"))
+		Expect(libtime.UnixTime(now)).NotTo(test.BeSameDay("2024-03-06"))
+		berlin := time.FixedZone("CET", 3600)
+		lateEvening := time.Date(2024, time.March, 5, 23, 30, 0, 0, time.UTC)
+		Expect(lateEvening).To(test.BeSameDay(time.Date(2024, time.March, 6, 8, 0, 0, 0, berlin)))
+	})
+	It("BeWithin allows a tolerance", func() {
+		Expect(libtime.UnixMilliTime(now)).To(test.BeWithin(now, libtime.Millisecond))
+		Expect(libtime.DateTime(now)).To(test.BeWithin(now.Add(-time.Second), libtime.Second))
+		Expect(libtime.DateTime(now)).NotTo(test.BeWithin(now.Add(-time.Second), libtime.Millisecond))
+	})
+	It("fails for values that are no time", func() {
+		success, err := test.BeDateTime(now).Match(42)
+		Expect(err).NotTo(BeNil())
+		Expect(success).To(BeFalse())
+		var nilDateTime *libtime.DateTime
+		_, err = test.BeDateTime(now).Match(nilDateTime)
+		Expect(err).NotTo(BeNil())
+	})
+	It("describes failures with times", func() {
+		matcher := test.BeWithin(now, libtime.Second)
+		Expect(matcher.FailureMessage(libtime.DateTime(now.Add(time.Hour)))).To(And(
+			ContainSubstring("2024-03-05T11:00:00.123456789Z (time.DateTime)"),
+			ContainSubstring("to be within 1s of"),
+		))
+	})
+})
@@ -6,6 +6,8 @@ package time
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"encoding"
 	"encoding/json"
 	"strings"
@@ -14,6 +16,7 @@ import (
 	"github.com/bborbe/errors"
 	"github.com/bborbe/parse"
 	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
 )
 
 type DateOrDateTimes []DateOrDateTime
@@ -59,13 +62,22 @@ func ParseDateOrDateTime(ctx context.Context, value interface{}) (*DateOrDateTim
 	if err != nil {
 		return nil, errors.Wrapf(ctx, err, "parse value failed")
 	}
-	t, err := ParseTime(ctx, str)
+	t, err := parseDateOrDateTime(ctx, str)
 	if err != nil {
 		return nil, errors.Wrapf(ctx, err, "parse time failed")
 	}
 	return DateOrDateTimePtr(t), nil
 }
 
+// parseDateOrDateTime parses dates like "2006-01-02" as midnight UTC, independent of the location
+// of the Parser, so they stay dates. Everything else is parsed with ParseTime.
+func parseDateOrDateTime(ctx context.Context, str string) (*stdtime.Time, error) {
+	if t, err := stdtime.Parse(stdtime.DateOnly, str); err == nil {
+		return &t, nil
+	}
+	return ParseTime(ctx, str)
+}
+
 func DateOrDateTimePtr(value *stdtime.Time) *DateOrDateTime {
 	if value == nil {
 		return nil
@@ -90,6 +102,14 @@ var _ encoding.TextMarshaler = DateOrDateTime{}
 
 var _ encoding.TextUnmarshaler = (*DateOrDateTime)(nil)
 
+var _ sql.Scanner = (*DateOrDateTime)(nil)
+
+var _ driver.Valuer = DateOrDateTime{}
+
+var _ yaml.Marshaler = DateOrDateTime{}
+
+var _ yaml.Unmarshaler = (*DateOrDateTime)(nil)
+
 // isMidnightUTC reports whether t is exactly midnight UTC (all time components zero in UTC).
 // This is the key discriminator for the round-trip serialization rule.
 func isMidnightUTC(t stdtime.Time) bool {
@@ -171,6 +191,8 @@ func (d *DateOrDateTime) ClonePtr() *DateOrDateTime {
 	return d.Clone().Ptr()
 }
 
+// UnmarshalJSON parses like ParseDateOrDateTime with the default Parser, because
+// json.Unmarshal passes no context.
 func (d *DateOrDateTime) UnmarshalJSON(b []byte) error {
 	str := strings.Trim(string(b), `"`)
 	switch str {
@@ -178,7 +200,7 @@ func (d *DateOrDateTime) UnmarshalJSON(b []byte) error {
 		*d = DateOrDateTime(stdtime.Time{})
 		return nil
 	default:
-		t, err := ParseTime(context.Background(), str)
+		t, err := parseDateOrDateTime(context.Background(), str)
 		if err != nil {
 			return errors.Wrapf(context.Background(), err, "parse time failed")
 		}
@@ -215,7 +237,7 @@ func (d *DateOrDateTime) UnmarshalText(b []byte) error {
 		*d = DateOrDateTime(stdtime.Time{})
 		return nil
 	}
-	t, err := ParseTime(context.Background(), str)
+	t, err := parseDateOrDateTime(context.Background(), str)
 	if err != nil {
 		return errors.Wrapf(context.Background(), err, "parse time failed")
 	}
@@ -223,6 +245,35 @@ func (d *DateOrDateTime) UnmarshalText(b []byte) error {
 	return nil
 }
 
+// MarshalYAML implements yaml.Marshaler. The zero DateOrDateTime is written as null like in MarshalJSON.
+func (d DateOrDateTime) MarshalYAML() (interface{}, error) {
+	return marshalYAMLText(d)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. Empty values and "null" result in the zero DateOrDateTime.
+func (d *DateOrDateTime) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLText(node, d)
+}
+
+// Scan implements sql.Scanner. NULL scans into the zero DateOrDateTime.
+func (d *DateOrDateTime) Scan(src interface{}) error {
+	t, err := scanSQLTime(context.Background(), src)
+	if err != nil {
+		return errors.Wrapf(context.Background(), err, "scan date or datetime failed")
+	}
+	*d = DateOrDateTime(t)
+	return nil
+}
+
+// Value implements driver.Valuer. The zero DateOrDateTime is stored as NULL.
+func (d DateOrDateTime) Value() (driver.Value, error) {
+	t := d.Time()
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t, nil
+}
+
 func (d DateOrDateTime) MarshalBinary() ([]byte, error) {
 	return d.Time().MarshalBinary()
 }
@@ -697,3 +697,26 @@ var _ = Describe("DateOrDateTime", func() {
 		})
 	})
 })
+
+var _ = Describe("DateOrDateTime in location", func() {
+	BeforeEach(func() {
+		berlin, err := libtime.LoadLocation(context.Background(), "Europe/Berlin")
+		Expect(err).To(BeNil())
+		libtime.SetDefaultParser(libtime.GetDefaultParser().WithLocation(berlin))
+		DeferCleanup(func() {
+			libtime.SetDefaultParser(libtime.NewParser(libtime.ParserOptions{}))
+		})
+	})
+	It("unmarshals zone-less date times in the location of the default parser", func() {
+		var result libtime.DateOrDateTime
+		Expect(json.Unmarshal([]byte(`"2024-03-01 10:00:00"`), &result)).To(Succeed())
+		Expect(result.UTC().Format(time.RFC3339)).To(Equal("2024-03-01T09:00:00Z"))
+	})
+	It("keeps dates as dates", func() {
+		var result libtime.DateOrDateTime
+		Expect(json.Unmarshal([]byte(`"2024-03-01"`), &result)).To(Succeed())
+		bytes, err := json.Marshal(result)
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal(`"2024-03-01"`))
+	})
+})
@@ -6,10 +6,13 @@ package time
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	stdtime "time"
 
 	"github.com/bborbe/errors"
 	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
 )
 
 type DateRanges []DateRange
@@ -70,6 +73,14 @@ func DateRangeFromTime(from, until stdtime.Time) DateRange {
 	}
 }
 
+var _ sql.Scanner = (*DateRange)(nil)
+
+var _ driver.Valuer = DateRange{}
+
+var _ yaml.Marshaler = DateRange{}
+
+var _ yaml.Unmarshaler = (*DateRange)(nil)
+
 type DateRange struct {
 	From  Date `json:"from,omitempty"`
 	Until Date `json:"until,omitempty"`
@@ -96,6 +107,58 @@ func (r DateRange) Ptr() *DateRange {
 	return &r
 }
 
+// MarshalYAML implements yaml.Marshaler and writes from and until. Zero sides are omitted.
+func (r DateRange) MarshalYAML() (interface{}, error) {
+	return yamlRange[Date]{From: r.From, Until: r.Until}, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. From and until accept the same values
+// as Date.UnmarshalYAML.
+func (r *DateRange) UnmarshalYAML(node *yaml.Node) error {
+	result, err := unmarshalYAMLRange[Date](node)
+	if err != nil {
+		return errors.Wrapf(context.Background(), err, "unmarshal DateRange failed")
+	}
+	*r = DateRange{From: result.From, Until: result.Until}
+	return nil
+}
+
+// SQLRange returns the canonical Postgres daterange literal like "[2024-01-01,2024-02-01)".
+// Zero From or Until are unbounded.
+func (r DateRange) SQLRange() string {
+	var lower, upper string
+	if from := r.From.Time(); !from.IsZero() {
+		lower = from.Format(stdtime.DateOnly)
+	}
+	if until := r.Until.Time(); !until.IsZero() {
+		upper = until.AddDate(0, 0, 1).Format(stdtime.DateOnly)
+	}
+	return formatSQLRange(lower, upper, false)
+}
+
+// Scan implements sql.Scanner for daterange columns. NULL and "(,)" scan into the zero DateRange
+// and "empty" is an error. Use SQLDateRange to keep them apart.
+func (r *DateRange) Scan(src interface{}) error {
+	ctx := context.Background()
+	var result SQLDateRange
+	if err := result.Scan(src); err != nil {
+		return err
+	}
+	if result.Empty {
+		return errors.Wrapf(ctx, validation.Error, "empty range can not be scanned into DateRange")
+	}
+	*r = result.Range
+	return nil
+}
+
+// Value implements driver.Valuer and returns SQLRange. The zero DateRange is stored as NULL.
+func (r DateRange) Value() (driver.Value, error) {
+	if r.From.Time().IsZero() && r.Until.Time().IsZero() {
+		return nil, nil
+	}
+	return r.SQLRange(), nil
+}
+
 // DayDateRange creates a DateRange covering the entire day containing the given date.
 // The range spans from 00:00:00.000000000 to 23:59:59.999999999 of that day.
 func DayDateRange(d Date) DateRange {
@@ -6,10 +6,13 @@ package time
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	stdtime "time"
 
 	"github.com/bborbe/errors"
 	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
 )
 
 type DateTimeRanges []DateTimeRange
@@ -70,6 +73,14 @@ func DateTimeRangeFromTime(from, until stdtime.Time) DateTimeRange {
 	}
 }
 
+var _ sql.Scanner = (*DateTimeRange)(nil)
+
+var _ driver.Valuer = DateTimeRange{}
+
+var _ yaml.Marshaler = DateTimeRange{}
+
+var _ yaml.Unmarshaler = (*DateTimeRange)(nil)
+
 type DateTimeRange struct {
 	From  DateTime `json:"from,omitempty"`
 	Until DateTime `json:"until,omitempty"`
@@ -96,6 +107,64 @@ func (r DateTimeRange) Ptr() *DateTimeRange {
 	return &r
 }
 
+// MarshalYAML implements yaml.Marshaler and writes from and until. Zero sides are omitted.
+func (r DateTimeRange) MarshalYAML() (interface{}, error) {
+	return yamlRange[DateTime]{From: r.From, Until: r.Until}, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. From and until accept the same values
+// as DateTime.UnmarshalYAML.
+func (r *DateTimeRange) UnmarshalYAML(node *yaml.Node) error {
+	result, err := unmarshalYAMLRange[DateTime](node)
+	if err != nil {
+		return errors.Wrapf(context.Background(), err, "unmarshal DateTimeRange failed")
+	}
+	*r = DateTimeRange{From: result.From, Until: result.Until}
+	return nil
+}
+
+// SQLRange returns the Postgres tstzrange literal like "[2024-01-01T00:00:00Z,2024-02-01T00:00:00Z)".
+// Until is written as exclusive bound if that fits the microsecond precision of Postgres,
+// otherwise as inclusive bound. Zero From or Until are unbounded.
+func (r DateTimeRange) SQLRange() string {
+	var lower, upper string
+	if from := r.From.Time(); !from.IsZero() {
+		lower = from.Format(stdtime.RFC3339Nano)
+	}
+	upperInclusive := true
+	if until := r.Until.Time(); !until.IsZero() {
+		upper = until.Format(stdtime.RFC3339Nano)
+		if next := until.Add(stdtime.Nanosecond); next.Nanosecond()%1000 == 0 {
+			upper = next.Format(stdtime.RFC3339Nano)
+			upperInclusive = false
+		}
+	}
+	return formatSQLRange(lower, upper, upperInclusive)
+}
+
+// Scan implements sql.Scanner for tstzrange and tsrange columns. NULL and "(,)" scan into the zero DateTimeRange
+// and "empty" is an error. Use SQLDateTimeRange to keep them apart.
+func (r *DateTimeRange) Scan(src interface{}) error {
+	ctx := context.Background()
+	var result SQLDateTimeRange
+	if err := result.Scan(src); err != nil {
+		return err
+	}
+	if result.Empty {
+		return errors.Wrapf(ctx, validation.Error, "empty range can not be scanned into DateTimeRange")
+	}
+	*r = result.Range
+	return nil
+}
+
+// Value implements driver.Valuer and returns SQLRange. The zero DateTimeRange is stored as NULL.
+func (r DateTimeRange) Value() (driver.Value, error) {
+	if r.From.IsZero() && r.Until.IsZero() {
+		return nil, nil
+	}
+	return r.SQLRange(), nil
+}
+
 // DayDateTimeRange creates a DateTimeRange covering the entire day containing the given datetime.
 // The range spans from 00:00:00.000000000 to 23:59:59.999999999 of that day.
 func DayDateTimeRange(dt DateTime) DateTimeRange {
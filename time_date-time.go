@@ -6,6 +6,8 @@ package time
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"encoding"
 	"encoding/json"
 	"strings"
@@ -14,6 +16,7 @@ import (
 	"github.com/bborbe/errors"
 	"github.com/bborbe/parse"
 	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
 )
 
 type DateTimes []DateTime
@@ -62,6 +65,18 @@ func ParseDateTime(ctx context.Context, value interface{}) (*DateTime, error) {
 	return DateTimePtr(time), nil
 }
 
+// ParseDateTimeInLocation parses like ParseDateTime, but inputs without offset
+// like "2006-01-02 15:04:05" are interpreted as wall clock time in location instead of UTC.
+// A nil location means UTC.
+func ParseDateTimeInLocation(
+	ctx context.Context,
+	value interface{},
+	location *stdtime.Location,
+) (*DateTime, error) {
+	parser := ParserFromContext(ctx).WithLocation(location)
+	return ParseDateTime(ContextWithParser(ctx, parser), value)
+}
+
 func DateTimePtr(time *stdtime.Time) *DateTime {
 	if time == nil {
 		return nil
@@ -71,6 +86,8 @@ func DateTimePtr(time *stdtime.Time) *DateTime {
 
 // NewDateTime creates a DateTime representing the date and time specified by the given parameters.
 // It wraps the standard library's time.Date function with the same parameter signature.
+// Ambiguous and nonexistent local times are resolved like time.Date, use NewDateTimeWithResolver
+// to control it.
 func NewDateTime(
 	year int,
 	month stdtime.Month,
@@ -90,6 +107,14 @@ var _ encoding.TextMarshaler = DateTime{}
 
 var _ encoding.TextUnmarshaler = (*DateTime)(nil)
 
+var _ sql.Scanner = (*DateTime)(nil)
+
+var _ driver.Valuer = DateTime{}
+
+var _ yaml.Marshaler = DateTime{}
+
+var _ yaml.Unmarshaler = (*DateTime)(nil)
+
 func (d DateTime) Year() int {
 	return d.Time().Year()
 }
@@ -147,6 +172,9 @@ func (d DateTime) Ptr() *DateTime {
 	return &d
 }
 
+// UnmarshalJSON parses like ParseDateTime with the default Parser, because json.Unmarshal
+// passes no context. Decode into a string and use ParseDateTimeInLocation to parse
+// inputs without offset in a location.
 func (d *DateTime) UnmarshalJSON(b []byte) error {
 	str := strings.Trim(string(b), `"`)
 	switch str {
@@ -194,6 +222,35 @@ func (d *DateTime) UnmarshalText(b []byte) error {
 	return nil
 }
 
+// MarshalYAML implements yaml.Marshaler. The zero DateTime is written as null like in MarshalJSON.
+func (d DateTime) MarshalYAML() (interface{}, error) {
+	return marshalYAMLText(d)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. Empty values and "null" result in the zero DateTime.
+func (d *DateTime) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLText(node, d)
+}
+
+// Scan implements sql.Scanner. NULL scans into the zero DateTime.
+func (d *DateTime) Scan(src interface{}) error {
+	t, err := scanSQLTime(context.Background(), src)
+	if err != nil {
+		return errors.Wrapf(context.Background(), err, "scan datetime failed")
+	}
+	*d = DateTime(t)
+	return nil
+}
+
+// Value implements driver.Valuer. The zero DateTime is stored as NULL.
+func (d DateTime) Value() (driver.Value, error) {
+	t := d.Time()
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t, nil
+}
+
 func (d DateTime) Time() stdtime.Time {
 	return stdtime.Time(d)
 }
@@ -284,6 +341,11 @@ func (d DateTime) UTC() DateTime {
 	return DateTime(d.Time().UTC())
 }
 
+// In returns the same instant in location, for example a Location.
+func (d DateTime) In(location HasLocation) DateTime {
+	return DateTime(d.Time().In(location.Location()))
+}
+
 func (d DateTime) Weekday() Weekday {
 	return Weekday(d.Time().Weekday())
 }
@@ -571,3 +571,39 @@ var _ = Describe("DateTime", func() {
 		})
 	})
 })
+
+var _ = Describe("DateTime in location", func() {
+	var ctx context.Context
+	var berlin *time.Location
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		berlin, err = libtime.LoadLocation(ctx, "Europe/Berlin")
+		Expect(err).To(BeNil())
+	})
+	It("parses zone-less inputs in the location", func() {
+		result, err := libtime.ParseDateTimeInLocation(
+			ctx,
+			"2024-03-01 10:00:00",
+			berlin,
+		)
+		Expect(err).To(BeNil())
+		Expect(result.Format(time.RFC3339)).To(Equal("2024-03-01T10:00:00+01:00"))
+	})
+	It("unmarshals json with the default parser", func() {
+		libtime.SetDefaultParser(libtime.GetDefaultParser().WithLocation(berlin))
+		DeferCleanup(func() {
+			libtime.SetDefaultParser(libtime.NewParser(libtime.ParserOptions{}))
+		})
+		var result struct {
+			Naive  libtime.DateTime `json:"naive"`
+			Offset libtime.DateTime `json:"offset"`
+		}
+		Expect(json.Unmarshal(
+			[]byte(`{"naive":"2024-03-01 10:00:00","offset":"2024-03-01T10:00:00Z"}`),
+			&result,
+		)).To(Succeed())
+		Expect(result.Naive.UTC().Format(time.RFC3339)).To(Equal("2024-03-01T09:00:00Z"))
+		Expect(result.Offset.UTC().Format(time.RFC3339)).To(Equal("2024-03-01T10:00:00Z"))
+	})
+})
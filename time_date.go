@@ -6,6 +6,8 @@ package time
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"encoding"
 	"encoding/json"
 	"strings"
@@ -14,6 +16,7 @@ import (
 	"github.com/bborbe/errors"
 	"github.com/bborbe/parse"
 	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
 )
 
 type Dates []Date
@@ -68,6 +71,8 @@ func ToDate(value stdtime.Time) Date {
 
 // NewDate creates a Date representing the date specified by the given parameters.
 // It wraps the standard library's time.Date function with the same parameter signature.
+// Ambiguous and nonexistent local times are resolved like time.Date, use NewDateWithResolver
+// to control it.
 // Note: hour, min, sec, nsec and loc parameters are typically ignored for Date operations.
 func NewDate(
 	year int,
@@ -84,6 +89,14 @@ var _ encoding.TextMarshaler = Date{}
 
 var _ encoding.TextUnmarshaler = (*Date)(nil)
 
+var _ sql.Scanner = (*Date)(nil)
+
+var _ driver.Valuer = Date{}
+
+var _ yaml.Marshaler = Date{}
+
+var _ yaml.Unmarshaler = (*Date)(nil)
+
 func (d Date) Year() int {
 	return d.Time().Year()
 }
@@ -122,6 +135,8 @@ func (d *Date) ClonePtr() *Date {
 	return d.Clone().Ptr()
 }
 
+// UnmarshalJSON parses like ParseDate with the default Parser, because json.Unmarshal
+// passes no context.
 func (d *Date) UnmarshalJSON(b []byte) error {
 	str := strings.Trim(string(b), `"`)
 	if len(str) == 0 || str == "null" {
@@ -167,6 +182,35 @@ func (d *Date) UnmarshalText(b []byte) error {
 	return nil
 }
 
+// MarshalYAML implements yaml.Marshaler. The zero Date is written as null like in MarshalJSON.
+func (d Date) MarshalYAML() (interface{}, error) {
+	return marshalYAMLText(d)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. Empty values and "null" result in the zero Date.
+func (d *Date) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLText(node, d)
+}
+
+// Scan implements sql.Scanner. NULL scans into the zero Date.
+func (d *Date) Scan(src interface{}) error {
+	t, err := scanSQLTime(context.Background(), src)
+	if err != nil {
+		return errors.Wrapf(context.Background(), err, "scan date failed")
+	}
+	*d = ToDate(t)
+	return nil
+}
+
+// Value implements driver.Valuer. The zero Date is stored as NULL.
+func (d Date) Value() (driver.Value, error) {
+	t := d.Time()
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t, nil
+}
+
 func (d Date) Time() stdtime.Time {
 	return stdtime.Time(d)
 }
@@ -0,0 +1,224 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	stdtime "time"
+
+	"github.com/bborbe/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// DurationFormat defines how a Duration is written. ParseDuration reads all formats,
+// so every formatted Duration parses back to the same value.
+type DurationFormat int
+
+const (
+	// DurationFormatGo is the format of time.Duration.String like "219h0m0s".
+	// Duration marshals in this format.
+	DurationFormatGo DurationFormat = iota
+	// DurationFormatCompact uses weeks and days like "1w2d3h" like Duration.String.
+	// DurationCompact marshals in this format.
+	DurationFormatCompact
+	// DurationFormatISO8601 is the ISO 8601 duration format with days like "P9DT3H".
+	// Negative durations are prefixed with "-". DurationISO8601 marshals in this format.
+	DurationFormatISO8601
+)
+
+// iso8601DurationRegexp matches lowercase ISO 8601 durations without years and months,
+// which have no fixed length.
+var iso8601DurationRegexp = regexp.MustCompile(
+	`^p(?:(\d*\.?\d+)w)?(?:(\d*\.?\d+)d)?(?:t(?:(\d*\.?\d+)h)?(?:(\d*\.?\d+)m)?(?:(\d*\.?\d+)s)?)?$`,
+)
+
+// Format returns d in the given format. Unknown formats use DurationFormatGo.
+func (d Duration) Format(format DurationFormat) string {
+	switch format {
+	case DurationFormatCompact:
+		return d.String()
+	case DurationFormatISO8601:
+		return d.formatISO8601()
+	default:
+		return d.Duration().String()
+	}
+}
+
+func (d Duration) formatISO8601() string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var builder strings.Builder
+	if d < 0 {
+		builder.WriteString("-")
+	}
+	builder.WriteString("P")
+	remaining := durationMagnitude(d)
+	if days := remaining / uint64(Day); days > 0 {
+		remaining -= days * uint64(Day)
+		builder.WriteString(strconv.FormatUint(days, 10))
+		builder.WriteString("D")
+	}
+	if remaining == 0 {
+		return builder.String()
+	}
+	builder.WriteString("T")
+	if hours := remaining / uint64(Hour); hours > 0 {
+		remaining -= hours * uint64(Hour)
+		builder.WriteString(strconv.FormatUint(hours, 10))
+		builder.WriteString("H")
+	}
+	if minutes := remaining / uint64(Minute); minutes > 0 {
+		remaining -= minutes * uint64(Minute)
+		builder.WriteString(strconv.FormatUint(minutes, 10))
+		builder.WriteString("M")
+	}
+	if remaining > 0 {
+		builder.WriteString(strconv.FormatUint(remaining/uint64(Second), 10))
+		if nanoseconds := remaining % uint64(Second); nanoseconds > 0 {
+			fraction := strconv.FormatUint(nanoseconds+uint64(Second), 10)[1:]
+			builder.WriteString(".")
+			builder.WriteString(strings.TrimRight(fraction, "0"))
+		}
+		builder.WriteString("S")
+	}
+	return builder.String()
+}
+
+// parseISO8601Duration returns the magnitude of a lowercase ISO 8601 duration like "p1dt2h".
+func parseISO8601Duration(ctx context.Context, value string) (uint64, error) {
+	value = strings.ReplaceAll(value, ",", ".")
+	matches := iso8601DurationRegexp.FindStringSubmatch(value)
+	if matches == nil || value == "p" || strings.HasSuffix(value, "t") {
+		return 0, errors.Errorf(
+			ctx,
+			"'%s' is no ISO 8601 duration with weeks, days, hours, minutes or seconds",
+			value,
+		)
+	}
+	var result uint64
+	for i, unit := range []string{"w", "d", "h", "m", "s"} {
+		if matches[i+1] == "" {
+			continue
+		}
+		duration, err := parseAsDuration(ctx, matches[i+1], unit)
+		if err != nil {
+			return 0, errors.Wrapf(ctx, err, "parse %s failed", unit)
+		}
+		if result, err = addDurationMagnitude(ctx, result, duration); err != nil {
+			return 0, errors.Wrapf(ctx, err, "parse %s failed", unit)
+		}
+	}
+	return result, nil
+}
+
+// DurationCompact is a Duration that marshals in DurationFormatCompact like "1w2d3h".
+type DurationCompact Duration
+
+var _ encoding.TextMarshaler = DurationCompact(0)
+
+var _ encoding.TextUnmarshaler = (*DurationCompact)(nil)
+
+var _ yaml.Marshaler = DurationCompact(0)
+
+var _ yaml.Unmarshaler = (*DurationCompact)(nil)
+
+func (d DurationCompact) Duration() stdtime.Duration {
+	return stdtime.Duration(d)
+}
+
+func (d DurationCompact) Ptr() *DurationCompact {
+	return &d
+}
+
+func (d DurationCompact) String() string {
+	return Duration(d).Format(DurationFormatCompact)
+}
+
+func (d DurationCompact) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *DurationCompact) UnmarshalJSON(b []byte) error {
+	return (*Duration)(d).UnmarshalJSON(b)
+}
+
+// MarshalText writes DurationFormatCompact. Zero is written as empty text.
+func (d DurationCompact) MarshalText() ([]byte, error) {
+	if d == 0 {
+		return nil, nil
+	}
+	return []byte(d.String()), nil
+}
+
+func (d *DurationCompact) UnmarshalText(b []byte) error {
+	return (*Duration)(d).UnmarshalText(b)
+}
+
+func (d DurationCompact) MarshalYAML() (interface{}, error) {
+	return d.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler like Duration.
+func (d *DurationCompact) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLText(node, d)
+}
+
+// DurationISO8601 is a Duration that marshals in DurationFormatISO8601 like "P9DT3H".
+type DurationISO8601 Duration
+
+var _ encoding.TextMarshaler = DurationISO8601(0)
+
+var _ encoding.TextUnmarshaler = (*DurationISO8601)(nil)
+
+var _ yaml.Marshaler = DurationISO8601(0)
+
+var _ yaml.Unmarshaler = (*DurationISO8601)(nil)
+
+func (d DurationISO8601) Duration() stdtime.Duration {
+	return stdtime.Duration(d)
+}
+
+func (d DurationISO8601) Ptr() *DurationISO8601 {
+	return &d
+}
+
+func (d DurationISO8601) String() string {
+	return Duration(d).Format(DurationFormatISO8601)
+}
+
+func (d DurationISO8601) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *DurationISO8601) UnmarshalJSON(b []byte) error {
+	return (*Duration)(d).UnmarshalJSON(b)
+}
+
+// MarshalText writes DurationFormatISO8601. Zero is written as empty text.
+func (d DurationISO8601) MarshalText() ([]byte, error) {
+	if d == 0 {
+		return nil, nil
+	}
+	return []byte(d.String()), nil
+}
+
+func (d *DurationISO8601) UnmarshalText(b []byte) error {
+	return (*Duration)(d).UnmarshalText(b)
+}
+
+func (d DurationISO8601) MarshalYAML() (interface{}, error) {
+	return d.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler like Duration.
+func (d *DurationISO8601) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLText(node, d)
+}
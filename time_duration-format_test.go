@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("DurationFormat", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	d := 1*libtime.Week + 2*libtime.Day + 3*libtime.Hour + 4*libtime.Minute + 5500*libtime.Millisecond
+	DescribeTable("Format",
+		func(input libtime.Duration, format libtime.DurationFormat, expected string) {
+			Expect(input.Format(format)).To(Equal(expected))
+		},
+		Entry("go", d, libtime.DurationFormatGo, "219h4m5.5s"),
+		Entry("compact", d, libtime.DurationFormatCompact, "1w2d3h4m5s500ms"),
+		Entry("iso8601", d, libtime.DurationFormatISO8601, "P9DT3H4M5.5S"),
+		Entry("go negative", -90*libtime.Second, libtime.DurationFormatGo, "-1m30s"),
+		Entry("compact negative", -90*libtime.Second, libtime.DurationFormatCompact, "-1m30s"),
+		Entry("iso8601 negative", -90*libtime.Second, libtime.DurationFormatISO8601, "-PT1M30S"),
+		Entry("compact zero", libtime.Duration(0), libtime.DurationFormatCompact, "0s"),
+		Entry("iso8601 zero", libtime.Duration(0), libtime.DurationFormatISO8601, "PT0S"),
+		Entry("iso8601 days", 2*libtime.Day, libtime.DurationFormatISO8601, "P2D"),
+		Entry("iso8601 ns", libtime.Nanosecond, libtime.DurationFormatISO8601, "PT0.000000001S"),
+		Entry("compact µs", 1500*libtime.Nanosecond, libtime.DurationFormatCompact, "1.5µs"),
+		Entry(
+			"compact min",
+			libtime.Duration(math.MinInt64),
+			libtime.DurationFormatCompact,
+			"-15250w1d23h47m16s854.775808ms",
+		),
+		Entry("unknown", libtime.Hour, libtime.DurationFormat(42), "1h0m0s"),
+	)
+	DescribeTable("ParseDuration",
+		func(input string, expected libtime.Duration, expectError bool) {
+			result, err := libtime.ParseDuration(ctx, input)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(*result).To(Equal(expected))
+		},
+		Entry("iso8601", "P1DT2H", libtime.Day+2*libtime.Hour, false),
+		Entry("iso8601 weeks", "P2W", 2*libtime.Week, false),
+		Entry("iso8601 lowercase", "pt30m", 30*libtime.Minute, false),
+		Entry("iso8601 fraction", "PT1.5S", 1500*libtime.Millisecond, false),
+		Entry("iso8601 comma", "PT0,5H", 30*libtime.Minute, false),
+		Entry("iso8601 negative", "-PT1M", -libtime.Minute, false),
+		Entry("iso8601 years", "P1Y", libtime.Duration(0), true),
+		Entry("iso8601 months", "P1M", libtime.Duration(0), true),
+		Entry("iso8601 empty", "P", libtime.Duration(0), true),
+		Entry("iso8601 empty time", "P1DT", libtime.Duration(0), true),
+		Entry("µs", "1.5µs", 1500*libtime.Nanosecond, false),
+		Entry("greek μs", "2μs", 2*libtime.Microsecond, false),
+		Entry("max", "2562047h47m16.854775807s", libtime.Duration(math.MaxInt64), false),
+		Entry("min", "-2562047h47m16.854775808s", libtime.Duration(math.MinInt64), false),
+		Entry("overflow", "2562047h47m16.854775808s", libtime.Duration(0), true),
+		Entry("overflow weeks", "100000000w", libtime.Duration(0), true),
+		Entry("exact fraction", "16.854775807s", 16854775807*libtime.Nanosecond, false),
+	)
+	Context("round-trip", func() {
+		formats := map[string]libtime.DurationFormat{
+			"go":      libtime.DurationFormatGo,
+			"compact": libtime.DurationFormatCompact,
+			"iso8601": libtime.DurationFormatISO8601,
+		}
+		values := libtime.Durations{
+			0,
+			libtime.Nanosecond,
+			-libtime.Nanosecond,
+			999 * libtime.Nanosecond,
+			libtime.Microsecond + libtime.Nanosecond,
+			1500 * libtime.Microsecond,
+			libtime.Second + libtime.Nanosecond,
+			-90 * libtime.Second,
+			59*libtime.Minute + 30*libtime.Second,
+			-(libtime.Week + libtime.Nanosecond),
+			10*libtime.Week + 5*libtime.Day + 23*libtime.Hour + 59*libtime.Minute + 30*libtime.Second,
+			math.MaxInt64,
+			math.MinInt64,
+			math.MinInt64 + 1,
+		}
+		random := rand.New(rand.NewSource(42))
+		for i := 0; i < 500; i++ {
+			values = append(values,
+				libtime.Duration(random.Int63()),
+				libtime.Duration(-random.Int63()),
+				libtime.Duration(random.Int63n(int64(libtime.Second))),
+				libtime.Duration(random.Int63n(int64(libtime.Week))).Truncate(libtime.Millisecond),
+			)
+		}
+		for name, format := range formats {
+			It("parses "+name+" back to the same duration", func() {
+				for _, value := range values {
+					str := value.Format(format)
+					result, err := libtime.ParseDuration(ctx, str)
+					Expect(err).To(BeNil(), str)
+					Expect(*result).To(Equal(value), str)
+				}
+			})
+		}
+		It("marshals json back to the same duration", func() {
+			type TestStruct struct {
+				Go      libtime.Duration        `json:"go"`
+				Compact libtime.DurationCompact `json:"compact"`
+				ISO8601 libtime.DurationISO8601 `json:"iso8601"`
+			}
+			for _, value := range values {
+				original := TestStruct{
+					Go:      value,
+					Compact: libtime.DurationCompact(value),
+					ISO8601: libtime.DurationISO8601(value),
+				}
+				bytes, err := json.Marshal(original)
+				Expect(err).To(BeNil())
+				var result TestStruct
+				Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+				Expect(result).To(Equal(original), string(bytes))
+			}
+		})
+		It("marshals yaml and text back to the same duration", func() {
+			for _, value := range values {
+				bytes, err := yaml.Marshal(libtime.DurationISO8601(value))
+				Expect(err).To(BeNil())
+				var iso8601 libtime.DurationISO8601
+				Expect(yaml.Unmarshal(bytes, &iso8601)).To(Succeed())
+				Expect(iso8601).To(Equal(libtime.DurationISO8601(value)), string(bytes))
+
+				bytes, err = libtime.DurationCompact(value).MarshalText()
+				Expect(err).To(BeNil())
+				var compact libtime.DurationCompact
+				Expect(compact.UnmarshalText(bytes)).To(Succeed())
+				Expect(compact).To(Equal(libtime.DurationCompact(value)), string(bytes))
+			}
+		})
+	})
+	It("marshals per type", func() {
+		type TestStruct struct {
+			Go      libtime.Duration        `json:"go"      yaml:"go"`
+			Compact libtime.DurationCompact `json:"compact" yaml:"compact"`
+			ISO8601 libtime.DurationISO8601 `json:"iso8601" yaml:"iso8601"`
+		}
+		original := TestStruct{
+			Go:      9 * libtime.Day,
+			Compact: libtime.DurationCompact(9 * libtime.Day),
+			ISO8601: libtime.DurationISO8601(9 * libtime.Day),
+		}
+		bytes, err := json.Marshal(original)
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal(`{"go":"216h0m0s","compact":"1w2d","iso8601":"P9D"}`))
+		bytes, err = yaml.Marshal(original)
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal("go: 216h0m0s\ncompact: 1w2d\niso8601: P9D\n"))
+		var result TestStruct
+		Expect(yaml.Unmarshal(bytes, &result)).To(Succeed())
+		Expect(result).To(Equal(original))
+	})
+	It("converts to time.Duration", func() {
+		Expect(libtime.DurationCompact(libtime.Hour).Duration()).To(Equal(libtime.Hour.Duration()))
+		Expect(libtime.DurationISO8601(libtime.Hour).Duration()).To(Equal(libtime.Hour.Duration()))
+		iso8601 := libtime.DurationISO8601(libtime.Hour)
+		Expect(*iso8601.Ptr()).To(Equal(iso8601))
+	})
+})
@@ -0,0 +1,165 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"math"
+	"slices"
+)
+
+// Round returns d rounded to the nearest multiple of duration, halfway values round away from zero.
+// Durations <= 0 return d unchanged.
+func (d Duration) Round(duration HasDuration) Duration {
+	return Duration(d.Duration().Round(duration.Duration()))
+}
+
+// Truncate returns d rounded toward zero to a multiple of duration.
+// Durations <= 0 return d unchanged.
+func (d Duration) Truncate(duration HasDuration) Duration {
+	return Duration(d.Duration().Truncate(duration.Duration()))
+}
+
+func (d Duration) Hours() float64 {
+	return d.Duration().Hours()
+}
+
+func (d Duration) Minutes() float64 {
+	return d.Duration().Minutes()
+}
+
+func (d Duration) Seconds() float64 {
+	return d.Duration().Seconds()
+}
+
+func (d Duration) Milliseconds() int64 {
+	return d.Duration().Milliseconds()
+}
+
+func (d Duration) Microseconds() int64 {
+	return d.Duration().Microseconds()
+}
+
+func (d Duration) Nanoseconds() int64 {
+	return d.Duration().Nanoseconds()
+}
+
+// Mul returns d scaled by factor rounded to the nearest nanosecond.
+// Results beyond the range of Duration saturate at the minimum or maximum, NaN returns 0.
+func (d Duration) Mul(factor float64) Duration {
+	return durationFromFloat(float64(d) * factor)
+}
+
+// Div returns d divided by other like 90m / 1h = 1.5.
+// Division by zero returns +Inf, -Inf or NaN.
+func (d Duration) Div(other Duration) float64 {
+	return float64(d) / float64(other)
+}
+
+// Clamp returns d limited to the range from min to max.
+func (d Duration) Clamp(min Duration, max Duration) Duration {
+	if d < min {
+		return min
+	}
+	if d > max {
+		return max
+	}
+	return d
+}
+
+// StringWithPrecision returns d truncated to unit like String,
+// so 1h2m3.456s with Minute is printed as 1h2m.
+func (d Duration) StringWithPrecision(unit HasDuration) string {
+	if d < 0 {
+		return "-" + d.Abs().StringWithPrecision(unit)
+	}
+	return d.Truncate(unit).String()
+}
+
+// Min returns the shortest duration or 0 if t is empty.
+func (t Durations) Min() Duration {
+	if len(t) == 0 {
+		return 0
+	}
+	return slices.Min(t)
+}
+
+// Max returns the longest duration or 0 if t is empty.
+func (t Durations) Max() Duration {
+	if len(t) == 0 {
+		return 0
+	}
+	return slices.Max(t)
+}
+
+// Sum returns the total of all durations. Totals beyond the range of Duration saturate
+// at the minimum or maximum Duration like Mul.
+func (t Durations) Sum() Duration {
+	var result Duration
+	// wraps counts how often result wrapped around, the exact total is result + wraps*2^64
+	var wraps int
+	for _, duration := range t {
+		next := result + duration
+		if duration > 0 && next < result {
+			wraps++
+		}
+		if duration < 0 && next > result {
+			wraps--
+		}
+		result = next
+	}
+	switch {
+	case wraps > 0:
+		return math.MaxInt64
+	case wraps < 0:
+		return math.MinInt64
+	}
+	return result
+}
+
+// Mean returns the average duration or 0 if t is empty.
+// It does not overflow if the sum exceeds the range of Duration.
+func (t Durations) Mean() Duration {
+	if len(t) == 0 {
+		return 0
+	}
+	count := Duration(len(t))
+	var quotients, remainders Duration
+	for _, duration := range t {
+		quotients += duration / count
+		remainders += duration % count
+	}
+	return quotients + remainders/count
+}
+
+// Percentile returns the p-th percentile with p from 0 to 100, interpolating linearly
+// between the closest ranks. Percentile(50) is the median. Returns 0 if t is empty or p is NaN.
+func (t Durations) Percentile(p float64) Duration {
+	if len(t) == 0 || math.IsNaN(p) {
+		return 0
+	}
+	sorted := slices.Clone(t)
+	slices.Sort(sorted)
+	rank := math.Max(0, math.Min(100, p)) / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	fraction := rank - float64(lower)
+	// the distance between the ranks fits into uint64 even if it exceeds the range of Duration
+	distance := uint64(sorted[upper]) - uint64(sorted[lower])
+	offset := min(uint64(math.Round(float64(distance)*fraction)), distance)
+	return sorted[lower] + Duration(offset)
+}
+
+// durationFromFloat rounds nanoseconds to a Duration and saturates at its range.
+func durationFromFloat(nanoseconds float64) Duration {
+	switch {
+	case math.IsNaN(nanoseconds):
+		return 0
+	case nanoseconds >= math.MaxInt64:
+		return math.MaxInt64
+	case nanoseconds <= math.MinInt64:
+		return math.MinInt64
+	}
+	return Duration(math.Round(nanoseconds))
+}
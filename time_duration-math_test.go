@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("Duration math", func() {
+	d := 1*libtime.Hour + 2*libtime.Minute + 3456*libtime.Millisecond
+	DescribeTable("Round",
+		func(input libtime.Duration, unit libtime.Duration, expected libtime.Duration) {
+			Expect(input.Round(unit)).To(Equal(expected))
+		},
+		Entry("minute", d, libtime.Minute, 1*libtime.Hour+2*libtime.Minute),
+		Entry("second", d, libtime.Second, 1*libtime.Hour+2*libtime.Minute+3*libtime.Second),
+		Entry("halfway", 90*libtime.Second, libtime.Minute, 2*libtime.Minute),
+		Entry("negative halfway", -90*libtime.Second, libtime.Minute, -2*libtime.Minute),
+		Entry("zero unit", d, libtime.Duration(0), d),
+	)
+	DescribeTable("Truncate",
+		func(input libtime.Duration, unit libtime.Duration, expected libtime.Duration) {
+			Expect(input.Truncate(unit)).To(Equal(expected))
+		},
+		Entry("minute", d, libtime.Minute, 1*libtime.Hour+2*libtime.Minute),
+		Entry("hour", d, libtime.Hour, libtime.Hour),
+		Entry("negative", -90*libtime.Second, libtime.Minute, -libtime.Minute),
+		Entry("zero unit", d, libtime.Duration(0), d),
+	)
+	It("converts units", func() {
+		duration := 90 * libtime.Minute
+		Expect(duration.Hours()).To(Equal(1.5))
+		Expect(duration.Minutes()).To(Equal(90.0))
+		Expect(duration.Seconds()).To(Equal(5400.0))
+		Expect(duration.Milliseconds()).To(Equal(int64(5400000)))
+		Expect(duration.Microseconds()).To(Equal(int64(5400000000)))
+		Expect(duration.Nanoseconds()).To(Equal(int64(5400000000000)))
+	})
+	DescribeTable("Mul",
+		func(input libtime.Duration, factor float64, expected libtime.Duration) {
+			Expect(input.Mul(factor)).To(Equal(expected))
+		},
+		Entry("scale up", libtime.Hour, 1.5, 90*libtime.Minute),
+		Entry("scale down", libtime.Second, 0.25, 250*libtime.Millisecond),
+		Entry("negative", libtime.Minute, -2.0, -2*libtime.Minute),
+		Entry("rounds", libtime.Duration(3), 0.5, libtime.Duration(2)),
+		Entry("saturates max", libtime.Week, 1e12, libtime.Duration(math.MaxInt64)),
+		Entry("saturates min", libtime.Week, -1e12, libtime.Duration(math.MinInt64)),
+		Entry("nan", libtime.Hour, math.NaN(), libtime.Duration(0)),
+	)
+	It("divides", func() {
+		Expect((90 * libtime.Minute).Div(libtime.Hour)).To(Equal(1.5))
+		Expect((-30 * libtime.Second).Div(libtime.Minute)).To(Equal(-0.5))
+		Expect(math.IsInf(libtime.Hour.Div(0), 1)).To(BeTrue())
+	})
+	DescribeTable("Clamp",
+		func(input libtime.Duration, expected libtime.Duration) {
+			Expect(input.Clamp(libtime.Second, libtime.Minute)).To(Equal(expected))
+		},
+		Entry("below", libtime.Millisecond, libtime.Second),
+		Entry("inside", 30*libtime.Second, 30*libtime.Second),
+		Entry("above", libtime.Hour, libtime.Minute),
+	)
+	DescribeTable("StringWithPrecision",
+		func(input libtime.Duration, unit libtime.Duration, expected string) {
+			Expect(input.StringWithPrecision(unit)).To(Equal(expected))
+		},
+		Entry("minute", d, libtime.Minute, "1h2m"),
+		Entry("second", d, libtime.Second, "1h2m3s"),
+		Entry("millisecond", d, libtime.Millisecond, "1h2m3s456ms"),
+		Entry("day", 50*libtime.Hour, libtime.Day, "2d"),
+		Entry("below unit", 30*libtime.Second, libtime.Minute, "0s"),
+		Entry("negative", -d, libtime.Minute, "-1h2m"),
+	)
+})
+
+var _ = Describe("Durations", func() {
+	durations := libtime.Durations{
+		3 * libtime.Second,
+		1 * libtime.Second,
+		4 * libtime.Second,
+		2 * libtime.Second,
+	}
+	It("returns min and max", func() {
+		Expect(durations.Min()).To(Equal(1 * libtime.Second))
+		Expect(durations.Max()).To(Equal(4 * libtime.Second))
+	})
+	It("returns sum and mean", func() {
+		Expect(durations.Sum()).To(Equal(10 * libtime.Second))
+		Expect(durations.Mean()).To(Equal(2500 * libtime.Millisecond))
+	})
+	DescribeTable("Sum saturates",
+		func(input libtime.Durations, expected libtime.Duration) {
+			Expect(input.Sum()).To(Equal(expected))
+		},
+		Entry("max", libtime.Durations{math.MaxInt64, 1}, libtime.Duration(math.MaxInt64)),
+		Entry("min", libtime.Durations{math.MinInt64, -1}, libtime.Duration(math.MinInt64)),
+		Entry(
+			"far beyond max",
+			libtime.Durations{math.MaxInt64, math.MaxInt64, math.MaxInt64},
+			libtime.Duration(math.MaxInt64),
+		),
+		Entry(
+			"back in range",
+			libtime.Durations{math.MaxInt64, libtime.Hour, -2 * libtime.Hour},
+			libtime.Duration(math.MaxInt64)-libtime.Hour,
+		),
+		Entry(
+			"back in range from below",
+			libtime.Durations{math.MinInt64, -libtime.Hour, 2 * libtime.Hour},
+			libtime.Duration(math.MinInt64)+libtime.Hour,
+		),
+	)
+	It("returns the mean without overflow", func() {
+		large := libtime.Durations{math.MaxInt64 - 1, math.MaxInt64 - 3}
+		Expect(large.Mean()).To(Equal(libtime.Duration(math.MaxInt64 - 2)))
+	})
+	It("returns zero for empty durations", func() {
+		empty := libtime.Durations{}
+		Expect(empty.Min()).To(Equal(libtime.Duration(0)))
+		Expect(empty.Max()).To(Equal(libtime.Duration(0)))
+		Expect(empty.Sum()).To(Equal(libtime.Duration(0)))
+		Expect(empty.Mean()).To(Equal(libtime.Duration(0)))
+		Expect(empty.Percentile(50)).To(Equal(libtime.Duration(0)))
+	})
+	DescribeTable("Percentile",
+		func(p float64, expected libtime.Duration) {
+			Expect(durations.Percentile(p)).To(Equal(expected))
+		},
+		Entry("min", 0.0, 1*libtime.Second),
+		Entry("median", 50.0, 2500*libtime.Millisecond),
+		Entry("p90", 90.0, 3700*libtime.Millisecond),
+		Entry("max", 100.0, 4*libtime.Second),
+		Entry("below range", -10.0, 1*libtime.Second),
+		Entry("above range", 200.0, 4*libtime.Second),
+		Entry("nan", math.NaN(), libtime.Duration(0)),
+	)
+	It("interpolates between extreme durations without overflow", func() {
+		extremes := libtime.Durations{math.MinInt64, math.MaxInt64}
+		Expect(extremes.Percentile(0)).To(Equal(libtime.Duration(math.MinInt64)))
+		Expect(extremes.Percentile(50)).To(Equal(libtime.Duration(0)))
+		Expect(extremes.Percentile(100)).To(Equal(libtime.Duration(math.MaxInt64)))
+	})
+	It("does not sort in place", func() {
+		_ = durations.Percentile(50)
+		Expect(durations[0]).To(Equal(3 * libtime.Second))
+	})
+})
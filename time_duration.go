@@ -6,8 +6,12 @@ package time
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"encoding"
 	"encoding/json"
+	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
@@ -15,6 +19,7 @@ import (
 
 	"github.com/bborbe/errors"
 	"github.com/bborbe/parse"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -32,6 +37,8 @@ const (
 var UnitMap = map[string]Duration{
 	"ns": Nanosecond,
 	"us": Microsecond,
+	"µs": Microsecond,
+	"μs": Microsecond,
 	"ms": Millisecond,
 	"s":  Second,
 	"m":  Minute,
@@ -41,7 +48,7 @@ var UnitMap = map[string]Duration{
 }
 
 var durationRegexp = regexp.MustCompile(
-	`^((\d*\.?\d+)(w))?((\d*\.?\d+)(d))?((\d*\.?\d+)(h))?((\d*\.?\d+)(m))?((\d*\.?\d+)(s))?((\d*\.?\d+)(ms))?((\d*\.?\d+)(us))?((\d*\.?\d+)(ns))?$`,
+	`^((\d*\.?\d+)(w))?((\d*\.?\d+)(d))?((\d*\.?\d+)(h))?((\d*\.?\d+)(m))?((\d*\.?\d+)(s))?((\d*\.?\d+)(ms))?((\d*\.?\d+)(us|µs|μs))?((\d*\.?\d+)(ns))?$`,
 )
 
 type Durations []Duration
@@ -70,6 +77,8 @@ func ParseDurationDefault(ctx context.Context, value interface{}, defaultValue D
 	return *result
 }
 
+// ParseDuration parses nanoseconds like "1337", the formats of DurationFormat like "219h0m0s",
+// "1w2d3h" and "P9DT3H" and combinations of all units in UnitMap like "1.5h".
 func ParseDuration(ctx context.Context, value interface{}) (*Duration, error) {
 	if value == nil {
 		return nil, nil
@@ -96,7 +105,19 @@ func ParseDuration(ctx context.Context, value interface{}) (*Duration, error) {
 	if number, err := strconv.ParseInt(str, 10, 64); err == nil {
 		return Duration(number).Ptr(), err
 	}
+	result, err := parseDurationString(ctx, str)
+	if err != nil {
+		return nil, errors.Wrapf(
+			ctx,
+			&ParseError{Input: str, Position: -1, Reason: err.Error(), Err: err},
+			"parse failed",
+		)
+	}
+	return result, nil
+}
 
+// parseDurationString parses str without ParseError, which ParseDuration adds.
+func parseDurationString(ctx context.Context, str string) (*Duration, error) {
 	var isNegative bool
 	if len(str) > 0 && str[0] == '-' {
 		isNegative = true
@@ -107,11 +128,18 @@ func ParseDuration(ctx context.Context, value interface{}) (*Duration, error) {
 	}
 	// Convert to lowercase to support both uppercase and lowercase units
 	str = strings.ToLower(str)
-	var result Duration
+	if strings.HasPrefix(str, "p") {
+		magnitude, err := parseISO8601Duration(ctx, str)
+		if err != nil {
+			return nil, err
+		}
+		return durationFromMagnitude(ctx, magnitude, isNegative)
+	}
 	matches := durationRegexp.FindStringSubmatch(str)
 	if len(matches) == 0 {
-		return nil, errors.Errorf(ctx, "parse failed")
+		return nil, errors.Errorf(ctx, "unknown duration format")
 	}
+	var magnitude uint64
 	for i := 1; i < len(matches); i += 3 {
 		value := matches[i+1]
 		unit := matches[i+2]
@@ -120,26 +148,84 @@ func ParseDuration(ctx context.Context, value interface{}) (*Duration, error) {
 		}
 		duration, err := parseAsDuration(ctx, value, unit)
 		if err != nil {
-			return nil, errors.Wrapf(ctx, err, "parse failed")
+			return nil, err
+		}
+		if magnitude, err = addDurationMagnitude(ctx, magnitude, duration); err != nil {
+			return nil, err
 		}
-		result += duration
-	}
-	if isNegative {
-		result = result * -1
 	}
-	return &result, nil
+	return durationFromMagnitude(ctx, magnitude, isNegative)
 }
 
-func parseAsDuration(ctx context.Context, value string, unit string) (Duration, error) {
+// parseAsDuration returns value like "1.5" in unit as nanoseconds. Fractions are
+// converted like time.ParseDuration, so the output of String and time.Duration.String
+// parses back to the same duration.
+func parseAsDuration(ctx context.Context, value string, unit string) (uint64, error) {
 	factor, ok := UnitMap[unit]
 	if !ok {
 		return 0, errors.Errorf(ctx, "unkown unit '%s'", unit)
 	}
-	i, err := strconv.ParseFloat(value, 64)
-	if err != nil {
-		return 0, errors.Wrapf(ctx, err, "parse failed")
+	wholeStr, fractionStr, _ := strings.Cut(value, ".")
+	var result uint64
+	if wholeStr != "" {
+		whole, err := strconv.ParseUint(wholeStr, 10, 64)
+		if err != nil || whole > maxDurationMagnitude/uint64(factor) {
+			return 0, errors.Errorf(ctx, "duration '%s%s' overflows", value, unit)
+		}
+		result = whole * uint64(factor)
 	}
-	return Duration(i * float64(factor)), nil
+	var fraction uint64
+	scale := 1.0
+	for _, c := range fractionStr {
+		if fraction > (maxDurationMagnitude-1)/10 {
+			// ignore digits beyond the precision of fraction
+			break
+		}
+		fraction = fraction*10 + uint64(c-'0')
+		scale *= 10
+	}
+	return addDurationMagnitude(
+		ctx,
+		result,
+		uint64(float64(fraction)*(float64(factor)/scale)),
+	)
+}
+
+// maxDurationMagnitude is the magnitude of the minimum Duration.
+const maxDurationMagnitude = uint64(1) << 63
+
+// addDurationMagnitude returns a+b or an error if it exceeds maxDurationMagnitude.
+func addDurationMagnitude(ctx context.Context, a uint64, b uint64) (uint64, error) {
+	if a > maxDurationMagnitude-b || b > maxDurationMagnitude {
+		return 0, errors.Errorf(ctx, "duration overflows")
+	}
+	return a + b, nil
+}
+
+// durationFromMagnitude returns the Duration with the given magnitude and sign.
+func durationFromMagnitude(
+	ctx context.Context,
+	magnitude uint64,
+	isNegative bool,
+) (*Duration, error) {
+	if isNegative {
+		if magnitude == maxDurationMagnitude {
+			return Duration(math.MinInt64).Ptr(), nil
+		}
+		return Duration(-int64(magnitude)).Ptr(), nil
+	}
+	if magnitude == maxDurationMagnitude {
+		return nil, errors.Errorf(ctx, "duration overflows")
+	}
+	return Duration(magnitude).Ptr(), nil
+}
+
+// durationMagnitude returns the absolute value of d without overflow for the minimum Duration.
+func durationMagnitude(d Duration) uint64 {
+	if d < 0 {
+		return uint64(-(d + 1)) + 1
+	}
+	return uint64(d)
 }
 
 func DurationPtr(time *stdtime.Duration) *Duration {
@@ -155,6 +241,14 @@ var _ encoding.TextMarshaler = Duration(0)
 
 var _ encoding.TextUnmarshaler = (*Duration)(nil)
 
+var _ sql.Scanner = (*Duration)(nil)
+
+var _ driver.Valuer = Duration(0)
+
+var _ yaml.Marshaler = Duration(0)
+
+var _ yaml.Unmarshaler = (*Duration)(nil)
+
 func (d Duration) Duration() stdtime.Duration {
 	return stdtime.Duration(d)
 }
@@ -167,42 +261,46 @@ func (d Duration) Ptr() *Duration {
 	return &d
 }
 
+// String returns d in DurationFormatCompact like "1w2d3h4m5s500ms" or "-1h30m".
 func (d Duration) String() string {
 	var builder strings.Builder
-	remaining := d
+	if d < 0 {
+		builder.WriteString("-")
+	}
+	remaining := durationMagnitude(d)
 
-	if weeks := remaining / Week; weeks > 0 {
-		remaining = remaining - weeks*Week
-		builder.WriteString(strconv.Itoa(int(weeks)))
+	if weeks := remaining / uint64(Week); weeks > 0 {
+		remaining = remaining - weeks*uint64(Week)
+		builder.WriteString(strconv.FormatUint(weeks, 10))
 		builder.WriteString("w")
 	}
 
-	if days := remaining / Day; days > 0 {
-		remaining = remaining - days*Day
-		builder.WriteString(strconv.Itoa(int(days)))
+	if days := remaining / uint64(Day); days > 0 {
+		remaining = remaining - days*uint64(Day)
+		builder.WriteString(strconv.FormatUint(days, 10))
 		builder.WriteString("d")
 	}
 
-	if hours := remaining / Hour; hours > 0 {
-		remaining = remaining - hours*Hour
-		builder.WriteString(strconv.Itoa(int(hours)))
+	if hours := remaining / uint64(Hour); hours > 0 {
+		remaining = remaining - hours*uint64(Hour)
+		builder.WriteString(strconv.FormatUint(hours, 10))
 		builder.WriteString("h")
 	}
 
-	if minutes := remaining / Minute; minutes > 0 {
-		remaining = remaining - minutes*Minute
-		builder.WriteString(strconv.Itoa(int(minutes)))
+	if minutes := remaining / uint64(Minute); minutes > 0 {
+		remaining = remaining - minutes*uint64(Minute)
+		builder.WriteString(strconv.FormatUint(minutes, 10))
 		builder.WriteString("m")
 	}
 
-	if seconds := remaining / Second; seconds > 0 {
-		remaining = remaining - seconds*Second
-		builder.WriteString(strconv.Itoa(int(seconds)))
+	if seconds := remaining / uint64(Second); seconds > 0 {
+		remaining = remaining - seconds*uint64(Second)
+		builder.WriteString(strconv.FormatUint(seconds, 10))
 		builder.WriteString("s")
 	}
 
 	if remaining > 0 || builder.Len() == 0 {
-		builder.WriteString(remaining.Duration().String())
+		builder.WriteString(stdtime.Duration(remaining).String())
 	}
 
 	return builder.String()
@@ -224,16 +322,18 @@ func (d *Duration) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalJSON writes DurationFormatGo like "219h0m0s".
+// Use DurationCompact or DurationISO8601 for other formats.
 func (d Duration) MarshalJSON() ([]byte, error) {
-	// use stdtime.Duration.String to produce output in standard golang format
-	return json.Marshal(d.Duration().String())
+	return json.Marshal(d.Format(DurationFormatGo))
 }
 
+// MarshalText writes DurationFormatGo like MarshalJSON. Zero is written as empty text.
 func (d Duration) MarshalText() ([]byte, error) {
 	if d.Duration() == 0 {
 		return nil, nil
 	}
-	return []byte(d.Duration().String()), nil
+	return []byte(d.Format(DurationFormatGo)), nil
 }
 
 func (d *Duration) UnmarshalText(b []byte) error {
@@ -250,3 +350,83 @@ func (d *Duration) UnmarshalText(b []byte) error {
 	*d = *duration
 	return nil
 }
+
+// MarshalYAML implements yaml.Marshaler and writes the Go duration format like MarshalJSON.
+func (d Duration) MarshalYAML() (interface{}, error) {
+	return d.Format(DurationFormatGo), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It accepts the same values as ParseDuration,
+// so "5m" and "1d" work in YAML configs. Empty values and "null" result in zero.
+func (d *Duration) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLText(node, d)
+}
+
+// Scan implements sql.Scanner for BIGINT nanoseconds and INTERVAL columns.
+// Intervals with months or years are rejected. NULL scans into zero.
+func (d *Duration) Scan(src interface{}) error {
+	ctx := context.Background()
+	switch v := src.(type) {
+	case nil:
+		*d = 0
+		return nil
+	case int64:
+		*d = Duration(v)
+		return nil
+	case []byte:
+		return d.Scan(string(v))
+	case string:
+		var duration *Duration
+		var err error
+		if v != "" && sqlIntervalRegexp.MatchString(v) {
+			duration, err = parseSQLInterval(ctx, v)
+		} else {
+			duration, err = ParseDuration(ctx, v)
+		}
+		if err != nil {
+			return errors.Wrapf(ctx, err, "scan duration failed")
+		}
+		*d = *duration
+		return nil
+	default:
+		return errors.Errorf(ctx, "can not scan %T into duration", src)
+	}
+}
+
+// Value implements driver.Valuer and returns nanoseconds for BIGINT columns.
+// Use SQLInterval for INTERVAL columns.
+func (d Duration) Value() (driver.Value, error) {
+	return int64(d), nil
+}
+
+// SQLInterval returns d as interval like "1 day 02:03:04.5" that INTERVAL columns accept.
+func (d Duration) SQLInterval() string {
+	var builder strings.Builder
+	if d < 0 {
+		builder.WriteString("-")
+	}
+	remaining := durationMagnitude(d)
+	if days := remaining / uint64(Day); days > 0 {
+		remaining -= days * uint64(Day)
+		builder.WriteString(strconv.FormatUint(days, 10))
+		if days == 1 {
+			builder.WriteString(" day ")
+		} else {
+			builder.WriteString(" days ")
+		}
+		if d < 0 {
+			builder.WriteString("-")
+		}
+	}
+	hours := remaining / uint64(Hour)
+	remaining -= hours * uint64(Hour)
+	minutes := remaining / uint64(Minute)
+	remaining -= minutes * uint64(Minute)
+	seconds := remaining / uint64(Second)
+	remaining -= seconds * uint64(Second)
+	builder.WriteString(fmt.Sprintf("%02d:%02d:%02d", hours, minutes, seconds))
+	if remaining > 0 {
+		builder.WriteString(strings.TrimRight(fmt.Sprintf(".%09d", remaining), "0"))
+	}
+	return builder.String()
+}
@@ -0,0 +1,19 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+// EqualWithin reports whether a and b are at most tolerance apart, in either direction.
+// A negative tolerance is treated as its absolute value.
+func EqualWithin(a, b HasTime, tolerance Duration) bool {
+	return a.Time().Sub(b.Time()).Abs() <= tolerance.Abs().Duration()
+}
+
+// EqualAtPrecision reports whether a and b are equal after truncating both to unit,
+// like comparing a millisecond precision database value with a nanosecond precision time.
+// Truncation is relative to the zero time, so units of a day and more use UTC days.
+// A unit less or equal zero compares exactly.
+func EqualAtPrecision(a, b HasTime, unit Duration) bool {
+	return a.Time().Truncate(unit.Duration()).Equal(b.Time().Truncate(unit.Duration()))
+}
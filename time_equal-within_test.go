@@ -0,0 +1,67 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	stdtime "time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("EqualWithin", func() {
+	base := libtime.DateTime(stdtime.Date(2024, stdtime.March, 5, 10, 0, 0, 0, stdtime.UTC))
+	DescribeTable("EqualWithin",
+		func(a, b libtime.HasTime, tolerance libtime.Duration, expected bool) {
+			Expect(libtime.EqualWithin(a, b, tolerance)).To(Equal(expected))
+			Expect(libtime.EqualWithin(b, a, tolerance)).To(Equal(expected))
+		},
+		Entry("equal", base, base, libtime.Duration(0), true),
+		Entry("inside", base, base.Add(999*libtime.Millisecond), libtime.Second, true),
+		Entry("at tolerance", base, base.Add(libtime.Second), libtime.Second, true),
+		Entry("outside", base, base.Add(libtime.Second+1), libtime.Second, false),
+		Entry("negative tolerance", base, base.Add(-libtime.Second), -libtime.Second, true),
+		Entry("across types", base, libtime.UnixTime(base.Time()), libtime.Duration(0), true),
+		Entry("date", libtime.Date(base.Time()), base, 10*libtime.Hour, true),
+	)
+	DescribeTable("EqualAtPrecision",
+		func(a, b libtime.HasTime, unit libtime.Duration, expected bool) {
+			Expect(libtime.EqualAtPrecision(a, b, unit)).To(Equal(expected))
+			Expect(libtime.EqualAtPrecision(b, a, unit)).To(Equal(expected))
+		},
+		Entry(
+			"millisecond",
+			base.Add(1500*libtime.Microsecond),
+			base.Add(libtime.Millisecond),
+			libtime.Millisecond,
+			true,
+		),
+		Entry(
+			"different millisecond",
+			base.Add(999*libtime.Microsecond),
+			base.Add(libtime.Millisecond),
+			libtime.Millisecond,
+			false,
+		),
+		Entry("second", base.Add(999*libtime.Millisecond), base, libtime.Second, true),
+		Entry("exact", base.Add(libtime.Nanosecond), base, libtime.Duration(0), false),
+		Entry(
+			"other location",
+			base,
+			base.In(libtime.NewLocation(stdtime.FixedZone("x", 3600))),
+			libtime.Minute,
+			true,
+		),
+		Entry(
+			"unix milli time",
+			libtime.UnixMilliTime(base.Add(libtime.Duration(123456789))),
+			base.Add(libtime.Duration(123456789)),
+			libtime.Millisecond,
+			true,
+		),
+	)
+})
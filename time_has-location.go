@@ -0,0 +1,12 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import stdtime "time"
+
+//counterfeiter:generate -o mocks/has-location.go --fake-name HasLocation . HasLocation
+type HasLocation interface {
+	Location() *stdtime.Location
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// HumanizeMonth is the approximate month (30 days) used by Humanize.
+	HumanizeMonth = 30 * Day
+	// HumanizeYear is the approximate year (365 days) used by Humanize.
+	HumanizeYear = 365 * Day
+)
+
+// HumanizeRounding defines how the smallest printed unit is rounded.
+type HumanizeRounding int
+
+const (
+	// HumanizeRound rounds half away from zero.
+	HumanizeRound HumanizeRounding = iota
+	// HumanizeFloor always rounds down.
+	HumanizeFloor
+	// HumanizeCeil always rounds up.
+	HumanizeCeil
+)
+
+// HumanizeUnitName contains the names of a unit in a HumanizeLocale.
+// RelativeSingular and RelativePlural are used inside past and future phrases,
+// for languages that inflect there (German "2 Tage" vs. "vor 2 Tagen").
+// They fall back to Singular and Plural if empty.
+type HumanizeUnitName struct {
+	Singular         string
+	Plural           string
+	RelativeSingular string
+	RelativePlural   string
+}
+
+func (h HumanizeUnitName) name(count int64, relative bool) string {
+	if relative {
+		if count == 1 && h.RelativeSingular != "" {
+			return h.RelativeSingular
+		}
+		if count != 1 && h.RelativePlural != "" {
+			return h.RelativePlural
+		}
+	}
+	if count == 1 {
+		return h.Singular
+	}
+	return h.Plural
+}
+
+// HumanizeLocale is the phrase table used by Humanize.
+// Past and Future are fmt templates with a single %s for the amount.
+type HumanizeLocale struct {
+	JustNow       string
+	Past          string
+	Future        string
+	Separator     string
+	LastSeparator string
+	Units         map[Duration]HumanizeUnitName
+}
+
+var HumanizeLocaleEnglish = HumanizeLocale{
+	JustNow:       "just now",
+	Past:          "%s ago",
+	Future:        "in %s",
+	Separator:     ", ",
+	LastSeparator: " and ",
+	Units: map[Duration]HumanizeUnitName{
+		HumanizeYear:  {Singular: "year", Plural: "years"},
+		HumanizeMonth: {Singular: "month", Plural: "months"},
+		Week:          {Singular: "week", Plural: "weeks"},
+		Day:           {Singular: "day", Plural: "days"},
+		Hour:          {Singular: "hour", Plural: "hours"},
+		Minute:        {Singular: "minute", Plural: "minutes"},
+		Second:        {Singular: "second", Plural: "seconds"},
+	},
+}
+
+var HumanizeLocaleGerman = HumanizeLocale{
+	JustNow:       "gerade eben",
+	Past:          "vor %s",
+	Future:        "in %s",
+	Separator:     ", ",
+	LastSeparator: " und ",
+	Units: map[Duration]HumanizeUnitName{
+		HumanizeYear:  {Singular: "Jahr", Plural: "Jahre", RelativePlural: "Jahren"},
+		HumanizeMonth: {Singular: "Monat", Plural: "Monate", RelativePlural: "Monaten"},
+		Week:          {Singular: "Woche", Plural: "Wochen"},
+		Day:           {Singular: "Tag", Plural: "Tage", RelativePlural: "Tagen"},
+		Hour:          {Singular: "Stunde", Plural: "Stunden"},
+		Minute:        {Singular: "Minute", Plural: "Minuten"},
+		Second:        {Singular: "Sekunde", Plural: "Sekunden"},
+	},
+}
+
+// HumanizeLocales contains all known locales by language code.
+// Add entries to make further languages available via HumanizeLocaleByName.
+var HumanizeLocales = map[string]HumanizeLocale{
+	"en": HumanizeLocaleEnglish,
+	"de": HumanizeLocaleGerman,
+}
+
+// HumanizeLocaleByName returns the locale registered for the given language code
+// and falls back to English for unknown codes.
+func HumanizeLocaleByName(name string) HumanizeLocale {
+	if locale, ok := HumanizeLocales[strings.ToLower(name)]; ok {
+		return locale
+	}
+	return HumanizeLocaleEnglish
+}
+
+// HumanizeOptions configures Humanize. Zero values select the defaults:
+// English, one unit, seconds to years and HumanizeRound.
+type HumanizeOptions struct {
+	Locale      HumanizeLocale
+	Granularity int
+	MinUnit     Duration
+	MaxUnit     Duration
+	Rounding    HumanizeRounding
+}
+
+func (h HumanizeOptions) withDefaults() HumanizeOptions {
+	if h.Locale.Units == nil {
+		h.Locale = HumanizeLocaleEnglish
+	}
+	if h.Granularity <= 0 {
+		h.Granularity = 1
+	}
+	if h.MinUnit <= 0 {
+		h.MinUnit = Second
+	}
+	if h.MaxUnit <= 0 {
+		h.MaxUnit = HumanizeYear
+	}
+	return h
+}
+
+// units returns the locale units between MinUnit and MaxUnit, largest first.
+func (h HumanizeOptions) units() []Duration {
+	result := make([]Duration, 0, len(h.Locale.Units))
+	for unit := range h.Locale.Units {
+		if unit >= h.MinUnit && unit <= h.MaxUnit {
+			result = append(result, unit)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] > result[j]
+	})
+	return result
+}
+
+// Humanize returns a relative description of then compared to now like "3 hours ago" or "in 2 days".
+func Humanize(then, now DateTime) string {
+	return HumanizeWithOptions(then, now, HumanizeOptions{})
+}
+
+// HumanizeWithOptions is Humanize with configurable locale, granularity and rounding.
+func HumanizeWithOptions(then, now DateTime, options HumanizeOptions) string {
+	options = options.withDefaults()
+	duration := then.Sub(now)
+	parts := humanizeParts(duration.Abs(), options, true)
+	if len(parts) == 0 {
+		return options.Locale.JustNow
+	}
+	amount := joinHumanizeParts(parts, options.Locale)
+	if duration < 0 {
+		return fmt.Sprintf(options.Locale.Past, amount)
+	}
+	return fmt.Sprintf(options.Locale.Future, amount)
+}
+
+// Humanize returns the duration in words like "3 hours" using the default options.
+func (d Duration) Humanize() string {
+	return d.HumanizeWithOptions(HumanizeOptions{})
+}
+
+// HumanizeWithOptions returns the duration in words with configurable locale, granularity and rounding.
+// Negative durations are prefixed with "-".
+func (d Duration) HumanizeWithOptions(options HumanizeOptions) string {
+	options = options.withDefaults()
+	parts := humanizeParts(d.Abs(), options, false)
+	if len(parts) == 0 {
+		units := options.units()
+		if len(units) == 0 {
+			return "0"
+		}
+		smallest := units[len(units)-1]
+		return "0 " + options.Locale.Units[smallest].name(0, false)
+	}
+	result := joinHumanizeParts(parts, options.Locale)
+	if d < 0 {
+		return "-" + result
+	}
+	return result
+}
+
+//counterfeiter:generate -o mocks/humanizer.go --fake-name Humanizer . Humanizer
+type Humanizer interface {
+	// Humanize returns a relative description of the given time compared to the current time.
+	Humanize(then HasTime) string
+}
+
+// NewHumanizer returns a Humanizer that takes the reference time from currentDateTimeGetter.
+func NewHumanizer(
+	currentDateTimeGetter CurrentDateTimeGetter,
+	options HumanizeOptions,
+) Humanizer {
+	return &humanizer{
+		currentDateTimeGetter: currentDateTimeGetter,
+		options:               options,
+	}
+}
+
+type humanizer struct {
+	currentDateTimeGetter CurrentDateTimeGetter
+	options               HumanizeOptions
+}
+
+func (h *humanizer) Humanize(then HasTime) string {
+	return HumanizeWithOptions(DateTime(then.Time()), h.currentDateTimeGetter.Now(), h.options)
+}
+
+func humanizeParts(duration Duration, options HumanizeOptions, relative bool) []string {
+	units := options.units()
+	if len(units) == 0 {
+		return nil
+	}
+	lastUnit := func(d Duration) Duration {
+		for i, unit := range units {
+			if d >= unit {
+				return units[min(i+options.Granularity-1, len(units)-1)]
+			}
+		}
+		return units[len(units)-1]
+	}
+	duration = roundHumanize(duration, lastUnit(duration), options.Rounding)
+	smallest := lastUnit(duration)
+
+	var parts []string
+	for _, unit := range units {
+		if len(parts) == options.Granularity || unit < smallest {
+			break
+		}
+		count := int64(duration / unit)
+		if count == 0 {
+			continue
+		}
+		duration -= Duration(count) * unit
+		parts = append(
+			parts,
+			strconv.FormatInt(count, 10)+" "+options.Locale.Units[unit].name(count, relative),
+		)
+	}
+	return parts
+}
+
+func roundHumanize(duration Duration, unit Duration, rounding HumanizeRounding) Duration {
+	switch rounding {
+	case HumanizeFloor:
+		return duration - duration%unit
+	case HumanizeCeil:
+		if remainder := duration % unit; remainder > 0 {
+			return duration - remainder + unit
+		}
+		return duration
+	default:
+		return Duration(duration.Duration().Round(unit.Duration()))
+	}
+}
+
+func joinHumanizeParts(parts []string, locale HumanizeLocale) string {
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return strings.Join(parts[:len(parts)-1], locale.Separator) +
+		locale.LastSeparator +
+		parts[len(parts)-1]
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+	"github.com/bborbe/time/mocks"
+)
+
+var _ = Describe("Humanize", func() {
+	var now libtime.DateTime
+	BeforeEach(func() {
+		now = ParseDateTime("2024-06-15T12:00:00Z")
+	})
+	DescribeTable("Humanize",
+		func(then string, expected string) {
+			Expect(libtime.Humanize(ParseDateTime(then), now)).To(Equal(expected))
+		},
+		Entry("same time", "2024-06-15T12:00:00Z", "just now"),
+		Entry("sub second", "2024-06-15T11:59:59.4Z", "1 second ago"),
+		Entry("below half second", "2024-06-15T11:59:59.7Z", "just now"),
+		Entry("1 second ago", "2024-06-15T11:59:59Z", "1 second ago"),
+		Entry("3 hours ago", "2024-06-15T09:00:00Z", "3 hours ago"),
+		Entry("rounds up to 2 hours", "2024-06-15T10:20:00Z", "2 hours ago"),
+		Entry("rounds up to next unit", "2024-06-15T11:00:20Z", "1 hour ago"),
+		Entry("in 2 days", "2024-06-17T12:00:00Z", "in 2 days"),
+		Entry("in 1 week", "2024-06-22T12:00:00Z", "in 1 week"),
+		Entry("2 months ago", "2024-04-15T12:00:00Z", "2 months ago"),
+		Entry("1 year ago", "2023-06-15T12:00:00Z", "1 year ago"),
+	)
+	DescribeTable("HumanizeWithOptions",
+		func(then string, options libtime.HumanizeOptions, expected string) {
+			Expect(
+				libtime.HumanizeWithOptions(ParseDateTime(then), now, options),
+			).To(Equal(expected))
+		},
+		Entry(
+			"granularity 2",
+			"2024-06-15T10:29:40Z",
+			libtime.HumanizeOptions{Granularity: 2},
+			"1 hour and 30 minutes ago",
+		),
+		Entry(
+			"granularity 3",
+			"2024-06-13T10:29:40Z",
+			libtime.HumanizeOptions{Granularity: 3},
+			"2 days, 1 hour and 30 minutes ago",
+		),
+		Entry(
+			"granularity skips empty units",
+			"2024-06-17T12:05:00Z",
+			libtime.HumanizeOptions{Granularity: 3},
+			"in 2 days and 5 minutes",
+		),
+		Entry(
+			"floor",
+			"2024-06-15T10:20:00Z",
+			libtime.HumanizeOptions{Rounding: libtime.HumanizeFloor},
+			"1 hour ago",
+		),
+		Entry(
+			"ceil",
+			"2024-06-15T10:50:00Z",
+			libtime.HumanizeOptions{Rounding: libtime.HumanizeCeil},
+			"2 hours ago",
+		),
+		Entry(
+			"max unit days",
+			"2024-04-15T12:00:00Z",
+			libtime.HumanizeOptions{MaxUnit: libtime.Day},
+			"61 days ago",
+		),
+		Entry(
+			"min unit minutes",
+			"2024-06-15T11:59:20Z",
+			libtime.HumanizeOptions{MinUnit: libtime.Minute},
+			"1 minute ago",
+		),
+		Entry(
+			"german past",
+			"2024-06-13T12:00:00Z",
+			libtime.HumanizeOptions{Locale: libtime.HumanizeLocaleGerman},
+			"vor 2 Tagen",
+		),
+		Entry(
+			"german past singular",
+			"2024-06-14T12:00:00Z",
+			libtime.HumanizeOptions{Locale: libtime.HumanizeLocaleGerman},
+			"vor 1 Tag",
+		),
+		Entry(
+			"german future",
+			"2024-06-15T15:00:00Z",
+			libtime.HumanizeOptions{Locale: libtime.HumanizeLocaleGerman},
+			"in 3 Stunden",
+		),
+		Entry(
+			"german granularity",
+			"2024-06-15T13:30:00Z",
+			libtime.HumanizeOptions{Locale: libtime.HumanizeLocaleGerman, Granularity: 2},
+			"in 1 Stunde und 30 Minuten",
+		),
+		Entry(
+			"german just now",
+			"2024-06-15T12:00:00Z",
+			libtime.HumanizeOptions{Locale: libtime.HumanizeLocaleGerman},
+			"gerade eben",
+		),
+	)
+	DescribeTable("Duration.Humanize",
+		func(duration libtime.Duration, expected string) {
+			Expect(duration.Humanize()).To(Equal(expected))
+		},
+		Entry("zero", libtime.Duration(0), "0 seconds"),
+		Entry("1 second", libtime.Second, "1 second"),
+		Entry("90 minutes", 90*libtime.Minute, "2 hours"),
+		Entry("3 days", 3*libtime.Day, "3 days"),
+		Entry("negative", -3*libtime.Day, "-3 days"),
+	)
+	DescribeTable("Duration.HumanizeWithOptions",
+		func(duration libtime.Duration, options libtime.HumanizeOptions, expected string) {
+			Expect(duration.HumanizeWithOptions(options)).To(Equal(expected))
+		},
+		Entry(
+			"granularity 2",
+			libtime.Week+2*libtime.Day+3*libtime.Hour,
+			libtime.HumanizeOptions{Granularity: 2},
+			"1 week and 2 days",
+		),
+		Entry(
+			"german plural",
+			2*libtime.Day,
+			libtime.HumanizeOptions{Locale: libtime.HumanizeLocaleGerman},
+			"2 Tage",
+		),
+		Entry(
+			"german zero",
+			libtime.Duration(0),
+			libtime.HumanizeOptions{Locale: libtime.HumanizeLocaleGerman},
+			"0 Sekunden",
+		),
+	)
+	DescribeTable("HumanizeLocaleByName",
+		func(name string, expectedJustNow string) {
+			Expect(libtime.HumanizeLocaleByName(name).JustNow).To(Equal(expectedJustNow))
+		},
+		Entry("en", "en", "just now"),
+		Entry("de", "de", "gerade eben"),
+		Entry("DE", "DE", "gerade eben"),
+		Entry("unknown", "xx", "just now"),
+	)
+	Context("Humanizer", func() {
+		var currentDateTimeGetter *mocks.CurrentDateTimeGetter
+		var humanizer libtime.Humanizer
+		BeforeEach(func() {
+			currentDateTimeGetter = &mocks.CurrentDateTimeGetter{}
+			currentDateTimeGetter.NowReturns(now)
+			humanizer = libtime.NewHumanizer(currentDateTimeGetter, libtime.HumanizeOptions{})
+		})
+		It("uses current datetime as reference", func() {
+			Expect(
+				humanizer.Humanize(ParseDateTime("2024-06-15T09:00:00Z")),
+			).To(Equal("3 hours ago"))
+			Expect(currentDateTimeGetter.NowCallCount()).To(Equal(1))
+		})
+		It("accepts any HasTime", func() {
+			Expect(humanizer.Humanize(ParseDate("2024-06-17"))).To(Equal("in 2 days"))
+		})
+	})
+})
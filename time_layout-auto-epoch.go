@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	stdtime "time"
+
+	"github.com/bborbe/errors"
+	libparse "github.com/bborbe/parse"
+)
+
+// DefaultAutoEpochRange is the range of plausible times AutoEpochLayout uses
+// to infer whether a number is seconds, milliseconds, microseconds or nanoseconds.
+// The units do not overlap within this range.
+var DefaultAutoEpochRange = TimeRange{
+	From:  stdtime.Date(1980, stdtime.January, 1, 0, 0, 0, 0, stdtime.UTC),
+	Until: stdtime.Date(2200, stdtime.January, 1, 0, 0, 0, 0, stdtime.UTC),
+}
+
+var epochUnits = []struct {
+	layout      Layout
+	nanoseconds int64
+}{
+	{layout: SecondLayout, nanoseconds: int64(stdtime.Second)},
+	{layout: MilliLayout, nanoseconds: int64(stdtime.Millisecond)},
+	{layout: MicroLayout, nanoseconds: int64(stdtime.Microsecond)},
+	{layout: NanoLayout, nanoseconds: int64(stdtime.Nanosecond)},
+}
+
+var epochRegexp = regexp.MustCompile(`^(-?\d+)(?:\.(\d+))?$`)
+
+// ParseAutoEpoch parses an epoch number and infers its unit from DefaultAutoEpochRange.
+// Fractional values like 1700000000.123 and string-encoded numbers are accepted.
+func ParseAutoEpoch(ctx context.Context, value interface{}) (*stdtime.Time, error) {
+	return ParseAutoEpochInRange(ctx, value, DefaultAutoEpochRange)
+}
+
+// ParseAutoEpochInRange is ParseAutoEpoch with a custom range of plausible times.
+func ParseAutoEpochInRange(
+	ctx context.Context,
+	value interface{},
+	plausible TimeRange,
+) (*stdtime.Time, error) {
+	str, err := epochString(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "convert epoch to string failed")
+	}
+	matches := epochRegexp.FindStringSubmatch(str)
+	if matches == nil {
+		return nil, errors.Errorf(ctx, "'%s' is not an epoch number", str)
+	}
+	number, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse '%s' failed", matches[1])
+	}
+	layout, err := DetectEpochUnit(ctx, number, plausible)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "detect unit of '%s' failed", str)
+	}
+	var fraction int64
+	if digits := matches[2]; digits != "" {
+		if len(digits) > 9 {
+			digits = digits[:9]
+		}
+		fraction, err = strconv.ParseInt(digits+strings.Repeat("0", 9-len(digits)), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse fraction '%s' failed", matches[2])
+		}
+		if strings.HasPrefix(matches[1], "-") {
+			fraction = -fraction
+		}
+	}
+	t := epochTime(number, fraction, epochNanoseconds(layout))
+	return &t, nil
+}
+
+// DetectEpochUnit returns SecondLayout, MilliLayout, MicroLayout or NanoLayout
+// depending on which unit places value within plausible.
+// It fails if no unit or more than one unit does.
+func DetectEpochUnit(ctx context.Context, value int64, plausible TimeRange) (Layout, error) {
+	var result Layouts
+	for _, unit := range epochUnits {
+		t := epochTime(value, 0, unit.nanoseconds)
+		if !t.Before(plausible.From) && !t.After(plausible.Until) {
+			result = append(result, unit.layout)
+		}
+	}
+	switch len(result) {
+	case 0:
+		return "", errors.Errorf(
+			ctx,
+			"epoch %d is outside %s - %s for every unit",
+			value,
+			FormatTime(&plausible.From),
+			FormatTime(&plausible.Until),
+		)
+	case 1:
+		return result[0], nil
+	default:
+		return "", errors.Wrapf(ctx, ErrLayoutAmbiguous, "epoch %d matches units %v", value, result)
+	}
+}
+
+// FormatAutoEpoch formats t as epoch seconds with a fraction if t has sub-second precision.
+func FormatAutoEpoch(t stdtime.Time) string {
+	seconds := t.Unix()
+	nanoseconds := int64(t.Nanosecond())
+	if nanoseconds == 0 {
+		return strconv.FormatInt(seconds, 10)
+	}
+	sign := ""
+	if seconds < 0 {
+		seconds++
+		nanoseconds = int64(stdtime.Second) - nanoseconds
+		if seconds == 0 {
+			sign = "-"
+		}
+	}
+	fraction := strings.TrimRight(fmt.Sprintf("%09d", nanoseconds), "0")
+	return sign + strconv.FormatInt(seconds, 10) + "." + fraction
+}
+
+// epochTime returns the time of value in the given unit plus fraction nanoseconds of that unit.
+func epochTime(value int64, fraction int64, unitNanoseconds int64) stdtime.Time {
+	perSecond := int64(stdtime.Second) / unitNanoseconds
+	return stdtime.Unix(
+		value/perSecond,
+		value%perSecond*unitNanoseconds+fraction*unitNanoseconds/int64(stdtime.Second),
+	)
+}
+
+func epochNanoseconds(layout Layout) int64 {
+	for _, unit := range epochUnits {
+		if unit.layout == layout {
+			return unit.nanoseconds
+		}
+	}
+	return int64(stdtime.Second)
+}
+
+func epochString(ctx context.Context, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case json.Number:
+		return epochString(ctx, v.String())
+	case string:
+		str := strings.Trim(strings.TrimSpace(v), `"`)
+		if strings.ContainsAny(str, "eE") {
+			f, err := strconv.ParseFloat(str, 64)
+			if err != nil {
+				return "", errors.Wrapf(ctx, err, "parse '%s' as float failed", str)
+			}
+			return strconv.FormatFloat(f, 'f', -1, 64), nil
+		}
+		return str, nil
+	}
+	str, err := libparse.ParseString(ctx, value)
+	if err != nil {
+		return "", errors.Wrapf(ctx, err, "parse value failed")
+	}
+	return epochString(ctx, str)
+}
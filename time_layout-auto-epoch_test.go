@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("AutoEpochLayout", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	DescribeTable("Parse",
+		func(value interface{}, expected string, expectError bool) {
+			result, err := libtime.AutoEpochLayout.Parse(ctx, value)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(result).NotTo(BeNil())
+			Expect(result.UTC().Format(time.RFC3339Nano)).To(Equal(expected))
+		},
+		Entry("seconds", int64(1700000000), "2023-11-14T22:13:20Z", false),
+		Entry("milli", int64(1700000000123), "2023-11-14T22:13:20.123Z", false),
+		Entry("micro", int64(1700000000123456), "2023-11-14T22:13:20.123456Z", false),
+		Entry("nano", int64(1700000000123456789), "2023-11-14T22:13:20.123456789Z", false),
+		Entry("int", 1700000000, "2023-11-14T22:13:20Z", false),
+		Entry("string seconds", "1700000000", "2023-11-14T22:13:20Z", false),
+		Entry("string milli", "1700000000123", "2023-11-14T22:13:20.123Z", false),
+		Entry("quoted string", `"1700000000"`, "2023-11-14T22:13:20Z", false),
+		Entry("fractional seconds", "1700000000.123", "2023-11-14T22:13:20.123Z", false),
+		Entry("fractional milli", "1700000000123.5", "2023-11-14T22:13:20.1235Z", false),
+		Entry("float", 1700000000.5, "2023-11-14T22:13:20.5Z", false),
+		Entry("exponent", "1.7e9", "2023-11-14T22:13:20Z", false),
+		Entry("json number", json.Number("1700000000123"), "2023-11-14T22:13:20.123Z", false),
+		Entry("between seconds and milli", int64(50000000000), "", true),
+		Entry("before range", int64(1000), "", true),
+		Entry("negative", int64(-1700000000), "", true),
+		Entry("not a number", "banana", "", true),
+		Entry("empty", "", "", true),
+	)
+	DescribeTable("Format",
+		func(input time.Time, expected string) {
+			Expect(libtime.AutoEpochLayout.Format(input)).To(Equal(expected))
+		},
+		Entry("seconds", time.Unix(1700000000, 0), "1700000000"),
+		Entry("fraction", time.Unix(1700000000, 123000000), "1700000000.123"),
+		Entry("nano", time.Unix(1700000000, 123456789), "1700000000.123456789"),
+		Entry("negative fraction", time.Unix(-2, 500000000), "-1.5"),
+		Entry("negative below one", time.Unix(-1, 500000000), "-0.5"),
+	)
+	It("round trips format and parse", func() {
+		input := time.Unix(1700000000, 123456789)
+		result, err := libtime.AutoEpochLayout.Parse(ctx, libtime.AutoEpochLayout.Format(input))
+		Expect(err).To(BeNil())
+		Expect(result.Equal(input)).To(BeTrue())
+	})
+	It("parses values before 1980 with a custom range", func() {
+		plausible := libtime.TimeRange{
+			From:  time.Date(1970, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Until: time.Date(1971, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}
+		result, err := libtime.ParseAutoEpochInRange(ctx, "8640000.25", plausible)
+		Expect(err).To(BeNil())
+		Expect(result.UTC().Format(time.RFC3339Nano)).To(Equal("1970-04-11T00:00:00.25Z"))
+		result, err = libtime.ParseAutoEpochInRange(ctx, "1700000000", plausible)
+		Expect(err).NotTo(BeNil())
+		Expect(result).To(BeNil())
+	})
+	DescribeTable("DetectEpochUnit",
+		func(value int64, expected libtime.Layout) {
+			result, err := libtime.DetectEpochUnit(ctx, value, libtime.DefaultAutoEpochRange)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expected))
+		},
+		Entry("seconds", int64(1700000000), libtime.SecondLayout),
+		Entry("milli", int64(1700000000000), libtime.MilliLayout),
+		Entry("micro", int64(1700000000000000), libtime.MicroLayout),
+		Entry("nano", int64(1700000000000000000), libtime.NanoLayout),
+	)
+	It("returns ErrLayoutAmbiguous if several units are plausible", func() {
+		plausible := libtime.TimeRange{
+			From:  time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Until: time.Date(2200, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}
+		_, err := libtime.DetectEpochUnit(ctx, 1700000000, plausible)
+		Expect(err).NotTo(BeNil())
+		Expect(errors.Is(err, libtime.ErrLayoutAmbiguous)).To(BeTrue())
+		_, err = libtime.ParseAutoEpochInRange(ctx, "1700000000", plausible)
+		Expect(errors.Is(err, libtime.ErrLayoutAmbiguous)).To(BeTrue())
+	})
+})
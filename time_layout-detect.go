@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	stderrors "errors"
+	"sort"
+	"strings"
+
+	"github.com/bborbe/errors"
+)
+
+// ErrLayoutAmbiguous is returned by DetectLayout if several layouts parse all samples
+// but disagree on the result, like "01/02/2006" and "02/01/2006" for "01/02/2024".
+var ErrLayoutAmbiguous = stderrors.New("layout ambiguous")
+
+// ErrLayoutNotDetected is returned by DetectLayout if no layout parses all samples.
+var ErrLayoutNotDetected = stderrors.New("layout not detected")
+
+// LayoutMatch is the number of samples a candidate layout was able to parse.
+type LayoutMatch struct {
+	Layout  Layout
+	Matched int
+}
+
+type LayoutMatches []LayoutMatch
+
+// LayoutDetection is the result of DetectLayout.
+type LayoutDetection struct {
+	// Layout is the first candidate that parses all samples.
+	Layout Layout
+	// Ranking contains every candidate that parsed at least one sample,
+	// ordered by matched samples and then by candidate order.
+	Ranking LayoutMatches
+	// Samples is the number of non-empty samples.
+	Samples int
+}
+
+// DetectLayout returns the layout of candidates that parses all samples.
+// Empty samples are ignored. If several candidates parse all samples to the same
+// times (like RFC3339 and RFC3339Nano) the first wins and all are listed in Ranking.
+// If they parse a sample to different times, ErrLayoutAmbiguous is returned together with
+// the detection, so the caller can pick from Ranking.
+func DetectLayout(
+	ctx context.Context,
+	samples []string,
+	candidates Layouts,
+) (*LayoutDetection, error) {
+	var values []string
+	for _, sample := range samples {
+		if strings.TrimSpace(sample) != "" {
+			values = append(values, sample)
+		}
+	}
+	if len(values) == 0 {
+		return nil, errors.Wrapf(ctx, ErrLayoutNotDetected, "no samples")
+	}
+
+	var ranking LayoutMatches
+	var complete Layouts
+	for _, candidate := range candidates {
+		matched := 0
+		for _, value := range values {
+			if _, err := candidate.Parse(ctx, value); err == nil {
+				matched++
+			}
+		}
+		if matched == 0 {
+			continue
+		}
+		ranking = append(ranking, LayoutMatch{Layout: candidate, Matched: matched})
+		if matched == len(values) {
+			complete = append(complete, candidate)
+		}
+	}
+	sort.SliceStable(ranking, func(i, j int) bool {
+		return ranking[i].Matched > ranking[j].Matched
+	})
+	if len(complete) == 0 {
+		return nil, errors.Wrapf(
+			ctx,
+			ErrLayoutNotDetected,
+			"none of %d layouts parses all %d samples",
+			len(candidates),
+			len(values),
+		)
+	}
+	detection := &LayoutDetection{
+		Layout:  complete[0],
+		Ranking: ranking,
+		Samples: len(values),
+	}
+	if err := checkLayoutConflicts(ctx, values, complete); err != nil {
+		return detection, errors.Wrapf(ctx, err, "detect layout failed")
+	}
+	return detection, nil
+}
+
+// checkLayoutConflicts returns ErrLayoutAmbiguous if two layouts parse a value to different times.
+func checkLayoutConflicts(ctx context.Context, values []string, layouts Layouts) error {
+	for _, value := range values {
+		first, err := layouts[0].Parse(ctx, value)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "parse '%s' failed", value)
+		}
+		for _, layout := range layouts[1:] {
+			other, err := layout.Parse(ctx, value)
+			if err != nil {
+				return errors.Wrapf(ctx, err, "parse '%s' failed", value)
+			}
+			if !first.Equal(*other) {
+				return errors.Wrapf(
+					ctx,
+					ErrLayoutAmbiguous,
+					"'%s' is %s with layout '%s' but %s with layout '%s'",
+					value,
+					FormatTime(first),
+					layouts[0],
+					FormatTime(other),
+					layout,
+				)
+			}
+		}
+	}
+	return nil
+}
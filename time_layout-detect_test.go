@@ -0,0 +1,137 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("DetectLayout", func() {
+	var ctx context.Context
+	var samples []string
+	var candidates libtime.Layouts
+	var detection *libtime.LayoutDetection
+	var err error
+	BeforeEach(func() {
+		ctx = context.Background()
+		candidates = libtime.Layouts{
+			libtime.RFC3339Nano,
+			libtime.RFC3339,
+			libtime.DateLayout,
+			libtime.Layout("01/02/2006"),
+			libtime.Layout("02/01/2006"),
+		}
+	})
+	JustBeforeEach(func() {
+		detection, err = libtime.DetectLayout(ctx, samples, candidates)
+	})
+	Context("single matching layout", func() {
+		BeforeEach(func() {
+			samples = []string{"2024-01-02", "", "2024-12-31"}
+		})
+		It("returns the layout", func() {
+			Expect(err).To(BeNil())
+			Expect(detection).NotTo(BeNil())
+			Expect(detection.Layout).To(Equal(libtime.DateLayout))
+			Expect(detection.Samples).To(Equal(2))
+			Expect(detection.Ranking).To(Equal(libtime.LayoutMatches{
+				{Layout: libtime.DateLayout, Matched: 2},
+			}))
+		})
+	})
+	Context("several layouts with equal results", func() {
+		BeforeEach(func() {
+			samples = []string{"2024-01-02T10:00:00Z", "2024-01-03T11:00:00Z"}
+		})
+		It("returns the first layout and a ranking", func() {
+			Expect(err).To(BeNil())
+			Expect(detection).NotTo(BeNil())
+			Expect(detection.Layout).To(Equal(libtime.RFC3339Nano))
+			Expect(detection.Ranking).To(Equal(libtime.LayoutMatches{
+				{Layout: libtime.RFC3339Nano, Matched: 2},
+				{Layout: libtime.RFC3339, Matched: 2},
+			}))
+		})
+	})
+	Context("day month swap", func() {
+		BeforeEach(func() {
+			samples = []string{"01/02/2024", "03/04/2024"}
+		})
+		It("returns ambiguous error", func() {
+			Expect(err).NotTo(BeNil())
+			Expect(errors.Is(err, libtime.ErrLayoutAmbiguous)).To(BeTrue())
+		})
+		It("returns the detection with the candidates", func() {
+			Expect(detection).NotTo(BeNil())
+			Expect(detection.Layout).To(Equal(libtime.Layout("01/02/2006")))
+			Expect(detection.Samples).To(Equal(2))
+			Expect(detection.Ranking).To(Equal(libtime.LayoutMatches{
+				{Layout: libtime.Layout("01/02/2006"), Matched: 2},
+				{Layout: libtime.Layout("02/01/2006"), Matched: 2},
+			}))
+		})
+	})
+	Context("day month swap resolved by later sample", func() {
+		BeforeEach(func() {
+			samples = []string{"01/02/2024", "25/02/2024"}
+		})
+		It("returns the day first layout", func() {
+			Expect(err).To(BeNil())
+			Expect(detection).NotTo(BeNil())
+			Expect(detection.Layout).To(Equal(libtime.Layout("02/01/2006")))
+			Expect(detection.Ranking).To(Equal(libtime.LayoutMatches{
+				{Layout: libtime.Layout("02/01/2006"), Matched: 2},
+				{Layout: libtime.Layout("01/02/2006"), Matched: 1},
+			}))
+		})
+	})
+	Context("day month swap with equal day and month", func() {
+		BeforeEach(func() {
+			samples = []string{"05/05/2024"}
+		})
+		It("is not ambiguous", func() {
+			Expect(err).To(BeNil())
+			Expect(detection.Layout).To(Equal(libtime.Layout("01/02/2006")))
+		})
+	})
+	Context("no layout parses all samples", func() {
+		BeforeEach(func() {
+			samples = []string{"2024-01-02", "01/02/2024"}
+		})
+		It("returns not detected error", func() {
+			Expect(err).NotTo(BeNil())
+			Expect(errors.Is(err, libtime.ErrLayoutNotDetected)).To(BeTrue())
+			Expect(detection).To(BeNil())
+		})
+	})
+	Context("no samples", func() {
+		BeforeEach(func() {
+			samples = []string{"", " "}
+		})
+		It("returns not detected error", func() {
+			Expect(err).NotTo(BeNil())
+			Expect(errors.Is(err, libtime.ErrLayoutNotDetected)).To(BeTrue())
+		})
+	})
+	Context("strftime candidates", func() {
+		BeforeEach(func() {
+			samples = []string{"2024-W10-2"}
+			candidates = libtime.Layouts{
+				libtime.DateLayout,
+				libtime.StrftimeLayout("%G-W%V-%u").Layout(),
+			}
+		})
+		It("returns the strftime layout", func() {
+			Expect(err).To(BeNil())
+			Expect(detection.Layout).To(Equal(libtime.StrftimeLayout("%G-W%V-%u").Layout()))
+		})
+	})
+})
@@ -0,0 +1,217 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	stdtime "time"
+
+	"github.com/bborbe/errors"
+	libparse "github.com/bborbe/parse"
+)
+
+const icuLayoutPrefix = "icu:"
+
+// ICULayout is an ICU / Java DateTimeFormatter pattern like "yyyy-MM-dd'T'HH:mm".
+// Besides the common letters it supports D (day of year), Y and w (ISO week year and week),
+// e (ISO weekday), Q (quarter), S (fraction of second), X/x/Z (offsets), z and VV (zone).
+// Text in single quotes is copied verbatim, two single quotes produce one quote.
+type ICULayout string
+
+func (l ICULayout) String() string {
+	return string(l)
+}
+
+// Layout returns a Layout that formats and parses with the ICU pattern
+// and can be used in Layouts.
+func (l ICULayout) Layout() Layout {
+	return Layout(icuLayoutPrefix + l.String())
+}
+
+// GoLayout translates the pattern into an equivalent Go layout.
+// It fails if the pattern uses fields Go layouts can not express, like w or Q.
+func (l ICULayout) GoLayout(ctx context.Context) (Layout, error) {
+	tokens, err := l.tokens(ctx)
+	if err != nil {
+		return "", errors.Wrapf(ctx, err, "tokenize failed")
+	}
+	return tokens.goLayout(ctx, l.String())
+}
+
+func (l ICULayout) Validate(ctx context.Context) error {
+	if _, err := l.tokens(ctx); err != nil {
+		return errors.Wrapf(ctx, err, "invalid icu layout")
+	}
+	return nil
+}
+
+// Format returns t formatted with the pattern. Unsupported letters are copied verbatim.
+func (l ICULayout) Format(t stdtime.Time) string {
+	tokens, _ := l.tokens(context.Background())
+	return tokens.format(t)
+}
+
+func (l ICULayout) Parse(ctx context.Context, value interface{}) (*stdtime.Time, error) {
+	str, err := libparse.ParseString(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse value failed")
+	}
+	tokens, err := l.tokens(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "tokenize failed")
+	}
+	return tokens.parse(ctx, icuLayoutPrefix+l.String(), str)
+}
+
+// tokens splits the pattern into tokens. Unsupported letters are kept as literals
+// and reported in the returned error.
+func (l ICULayout) tokens(ctx context.Context) (patternTokens, error) {
+	var result patternTokens
+	var err error
+	pattern := l.String()
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		if c == '\'' {
+			literal, next, ok := icuQuoted(pattern, i)
+			if !ok {
+				err = errors.Errorf(ctx, "unterminated quote in '%s'", pattern)
+			}
+			result = appendLiteral(result, literal)
+			i = next
+			continue
+		}
+		if !isASCIILetter(c) {
+			result = appendLiteral(result, pattern[i:i+1])
+			i++
+			continue
+		}
+		count := 1
+		for i+count < len(pattern) && pattern[i+count] == c {
+			count++
+		}
+		token, ok := icuToken(c, count)
+		if !ok {
+			result = appendLiteral(result, pattern[i:i+count])
+			err = errors.Errorf(ctx, "unsupported field '%s' in '%s'", pattern[i:i+count], pattern)
+		} else {
+			result = append(result, token)
+		}
+		i += count
+	}
+	return result, err
+}
+
+// icuQuoted returns the literal starting with the quote at pattern[start]
+// and the index after the closing quote.
+func icuQuoted(pattern string, start int) (string, int, bool) {
+	if start+1 < len(pattern) && pattern[start+1] == '\'' {
+		return "'", start + 2, true
+	}
+	var literal []byte
+	for i := start + 1; i < len(pattern); i++ {
+		if pattern[i] != '\'' {
+			literal = append(literal, pattern[i])
+			continue
+		}
+		if i+1 < len(pattern) && pattern[i+1] == '\'' {
+			literal = append(literal, '\'')
+			i++
+			continue
+		}
+		return string(literal), i + 1, true
+	}
+	return string(literal), len(pattern), false
+}
+
+func icuToken(letter byte, count int) (patternToken, bool) {
+	switch letter {
+	case 'y', 'u':
+		if count == 2 {
+			return patternToken{kind: patternYear, width: 2}, true
+		}
+		return patternToken{kind: patternYear, width: 4}, true
+	case 'Y':
+		if count == 2 {
+			return patternToken{kind: patternISOYear, width: 2}, true
+		}
+		return patternToken{kind: patternISOYear, width: 4}, true
+	case 'Q', 'q':
+		return patternToken{kind: patternQuarter, width: min(count, 4)}, true
+	case 'M', 'L':
+		return patternToken{kind: patternMonth, width: min(count, 4)}, true
+	case 'w':
+		return patternToken{kind: patternISOWeek, width: min(count, 2)}, true
+	case 'd':
+		return patternToken{kind: patternDay, width: min(count, 2)}, true
+	case 'D':
+		if count >= 3 {
+			return patternToken{kind: patternDayOfYear, width: 3}, true
+		}
+		return patternToken{kind: patternDayOfYear, width: 1}, true
+	case 'E':
+		if count >= 4 {
+			return patternToken{kind: patternWeekdayName, width: 4}, true
+		}
+		return patternToken{kind: patternWeekdayName, width: 3}, true
+	case 'e', 'c':
+		switch {
+		case count == 3:
+			return patternToken{kind: patternWeekdayName, width: 3}, true
+		case count >= 4:
+			return patternToken{kind: patternWeekdayName, width: 4}, true
+		}
+		return patternToken{kind: patternWeekdayNumber, width: 1}, true
+	case 'a':
+		return patternToken{kind: patternAMPM, text: "PM"}, true
+	case 'H':
+		return patternToken{kind: patternHour, width: min(count, 2)}, true
+	case 'h':
+		return patternToken{kind: patternHour12, width: min(count, 2)}, true
+	case 'k':
+		return patternToken{kind: patternHourFrom1, width: min(count, 2)}, true
+	case 'K':
+		return patternToken{kind: patternHour11, width: min(count, 2)}, true
+	case 'm':
+		return patternToken{kind: patternMinute, width: min(count, 2)}, true
+	case 's':
+		return patternToken{kind: patternSecond, width: min(count, 2)}, true
+	case 'S':
+		return patternToken{kind: patternFraction, width: count}, true
+	case 'Z':
+		switch {
+		case count == 4:
+			return patternToken{kind: patternZoneOffset, text: "-07:00"}, true
+		case count >= 5:
+			return patternToken{kind: patternZoneOffset, text: "Z07:00"}, true
+		}
+		return patternToken{kind: patternZoneOffset, text: "-0700"}, true
+	case 'X':
+		return patternToken{kind: patternZoneOffset, text: icuOffsetLayout("Z", count)}, true
+	case 'x':
+		return patternToken{kind: patternZoneOffset, text: icuOffsetLayout("-", count)}, true
+	case 'z':
+		return patternToken{kind: patternZoneName}, true
+	case 'V':
+		return patternToken{kind: patternZoneID}, count == 2
+	default:
+		return patternToken{}, false
+	}
+}
+
+// icuOffsetLayout returns the Go layout for the X and x offset fields.
+func icuOffsetLayout(prefix string, count int) string {
+	switch {
+	case count == 1:
+		return prefix + "07"
+	case count == 2 || count == 4:
+		return prefix + "0700"
+	default:
+		return prefix + "07:00"
+	}
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("ICULayout", func() {
+	var ctx context.Context
+	var input time.Time
+	BeforeEach(func() {
+		ctx = context.Background()
+		input = time.Date(2024, time.March, 5, 14, 7, 9, 123456789, time.UTC)
+	})
+	DescribeTable("Format",
+		func(layout libtime.ICULayout, expected string) {
+			Expect(layout.Format(input)).To(Equal(expected))
+		},
+		Entry("date time", libtime.ICULayout("yyyy-MM-dd'T'HH:mm"), "2024-03-05T14:07"),
+		Entry("unpadded", libtime.ICULayout("d.M.yy H:m:s"), "5.3.24 14:7:9"),
+		Entry("names", libtime.ICULayout("EEE EEEE MMM MMMM"), "Tue Tuesday Mar March"),
+		Entry("12 hour", libtime.ICULayout("hh:mm a"), "02:07 PM"),
+		Entry("hour variants", libtime.ICULayout("k K"), "14 2"),
+		Entry("day of year", libtime.ICULayout("D DDD"), "65 065"),
+		Entry("iso week", libtime.ICULayout("YYYY-'W'ww-e"), "2024-W10-2"),
+		Entry("quarter", libtime.ICULayout("Q QQ QQQ QQQQ"), "1 01 Q1 1st quarter"),
+		Entry("fraction", libtime.ICULayout("ss.SSS"), "09.123"),
+		Entry("offsets", libtime.ICULayout("X XX XXX x Z"), "Z Z Z +00 +0000"),
+		Entry("zone", libtime.ICULayout("z VV"), "UTC UTC"),
+		Entry("quoted", libtime.ICULayout("'o''clock' h"), "o'clock 2"),
+		Entry("escaped quote", libtime.ICULayout("HH''mm"), "14'07"),
+	)
+	DescribeTable("Parse",
+		func(layout libtime.ICULayout, value string, expected string, expectError bool) {
+			result, err := layout.Parse(ctx, value)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(result).NotTo(BeNil())
+			Expect(result.Format(time.RFC3339Nano)).To(Equal(expected))
+		},
+		Entry(
+			"date time",
+			libtime.ICULayout("yyyy-MM-dd'T'HH:mm"),
+			"2024-03-05T14:07",
+			"2024-03-05T14:07:00Z",
+			false,
+		),
+		Entry(
+			"unpadded",
+			libtime.ICULayout("d.M.yyyy H:mm"),
+			"5.3.2024 9:07",
+			"2024-03-05T09:07:00Z",
+			false,
+		),
+		Entry(
+			"offset",
+			libtime.ICULayout("yyyy-MM-dd'T'HH:mm:ss.SSSXXX"),
+			"2024-03-05T14:07:09.123-05:00",
+			"2024-03-05T14:07:09.123-05:00",
+			false,
+		),
+		Entry(
+			"utc offset",
+			libtime.ICULayout("yyyy-MM-dd'T'HH:mm:ssXXX"),
+			"2024-03-05T14:07:09Z",
+			"2024-03-05T14:07:09Z",
+			false,
+		),
+		Entry(
+			"zone id",
+			libtime.ICULayout("yyyy-MM-dd HH:mm VV"),
+			"2024-07-05 14:07 Europe/Berlin",
+			"2024-07-05T14:07:00+02:00",
+			false,
+		),
+		Entry(
+			"iso week",
+			libtime.ICULayout("YYYY-'W'ww-e"),
+			"2024-W10-2",
+			"2024-03-05T00:00:00Z",
+			false,
+		),
+		Entry(
+			"quarter",
+			libtime.ICULayout("yyyy QQQ"),
+			"2024 Q2",
+			"2024-04-01T00:00:00Z",
+			false,
+		),
+		Entry(
+			"day of year",
+			libtime.ICULayout("yyyy DDD"),
+			"2024 366",
+			"2024-12-31T00:00:00Z",
+			false,
+		),
+		Entry(
+			"12 hour",
+			libtime.ICULayout("yyyy-MM-dd hh:mm a"),
+			"2024-03-05 02:07 PM",
+			"2024-03-05T14:07:00Z",
+			false,
+		),
+		Entry("mismatch", libtime.ICULayout("yyyy-MM-dd"), "2024/03/05", "", true),
+		Entry("invalid month", libtime.ICULayout("yyyy-MM-dd"), "2024-13-05", "", true),
+		Entry("invalid iso week", libtime.ICULayout("YYYY-'W'ww"), "2024-W53", "", true),
+		Entry("unsupported field", libtime.ICULayout("GGG yyyy"), "AD 2024", "", true),
+		Entry(
+			"weekday mismatch",
+			libtime.ICULayout("EEEE, yyyy-MM-dd"),
+			"Tuesday, 2024-03-04",
+			"",
+			true,
+		),
+	)
+	DescribeTable("GoLayout",
+		func(layout libtime.ICULayout, expected libtime.Layout, expectError bool) {
+			result, err := layout.GoLayout(ctx)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expected))
+		},
+		Entry(
+			"rfc3339",
+			libtime.ICULayout("yyyy-MM-dd'T'HH:mm:ssXXX"),
+			libtime.Layout("2006-01-02T15:04:05Z07:00"),
+			false,
+		),
+		Entry("names", libtime.ICULayout("EEE, d MMM yyyy"), libtime.Layout("Mon, 2 Jan 2006"), false),
+		Entry("unpadded 24 hour", libtime.ICULayout("H:mm"), libtime.Layout(""), true),
+		Entry("week", libtime.ICULayout("ww"), libtime.Layout(""), true),
+		Entry("unsafe literal", libtime.ICULayout("yyyy'1'"), libtime.Layout(""), true),
+	)
+	DescribeTable("Validate",
+		func(layout libtime.ICULayout, expectError bool) {
+			err := layout.Validate(ctx)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+			}
+		},
+		Entry("valid", libtime.ICULayout("yyyy-MM-dd'T'HH:mm"), false),
+		Entry("unsupported field", libtime.ICULayout("G"), true),
+		Entry("unterminated quote", libtime.ICULayout("yyyy'T"), true),
+	)
+	It("is used by Layouts.Parse", func() {
+		layouts := libtime.Layouts{
+			libtime.StrftimeLayout("%Y-%m-%d").Layout(),
+			libtime.ICULayout("dd.MM.yyyy HH:mm").Layout(),
+		}
+		result, err := layouts.Parse(ctx, "05.03.2024 14:07")
+		Expect(err).To(BeNil())
+		Expect(result.Format(time.RFC3339)).To(Equal("2024-03-05T14:07:00Z"))
+	})
+})
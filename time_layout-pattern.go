@@ -0,0 +1,702 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	stdtime "time"
+
+	"github.com/bborbe/errors"
+)
+
+// patternTokenKind identifies one field of a strftime or ICU pattern.
+type patternTokenKind int
+
+const (
+	patternLiteral patternTokenKind = iota
+	patternYear
+	patternMonth
+	patternDay
+	patternDayOfYear
+	patternWeekdayName
+	patternWeekdayNumber
+	patternWeekdayNumberFromSunday
+	patternHour
+	patternHour12
+	patternHourFrom1
+	patternHour11
+	patternAMPM
+	patternMinute
+	patternSecond
+	patternFraction
+	patternZoneOffset
+	patternZoneName
+	patternZoneID
+	patternISOWeek
+	patternISOYear
+	patternQuarter
+	patternWeekOfYearFromSunday
+	patternWeekOfYearFromMonday
+	patternEpoch
+)
+
+// patternToken is one literal or field of a pattern.
+// width is the number of digits for numeric fields (1 means unpadded),
+// 3 or 4 for short or long names and the digit count for fractions.
+// text is the literal text or the Go layout used to format AM/PM and zone offsets.
+type patternToken struct {
+	kind  patternTokenKind
+	width int
+	space bool
+	text  string
+}
+
+type patternTokens []patternToken
+
+// patternRegexpCacheSize limits the number of compiled patterns in patternRegexpCache,
+// because patterns can come from user input. Other patterns are compiled on every parse.
+const patternRegexpCacheSize = 256
+
+var patternRegexpCache sync.Map
+
+var patternRegexpCacheLen atomic.Int64
+
+func (p patternTokens) format(t stdtime.Time) string {
+	var builder strings.Builder
+	for _, token := range p {
+		builder.WriteString(token.format(t))
+	}
+	return builder.String()
+}
+
+func (p patternToken) format(t stdtime.Time) string {
+	switch p.kind {
+	case patternLiteral:
+		return p.text
+	case patternYear:
+		if p.width == 2 {
+			return t.Format("06")
+		}
+		return t.Format("2006")
+	case patternMonth:
+		switch p.width {
+		case 3:
+			return t.Format("Jan")
+		case 4:
+			return t.Format("January")
+		}
+		return p.formatInt(int(t.Month()))
+	case patternDay:
+		return p.formatInt(t.Day())
+	case patternDayOfYear:
+		return p.formatInt(t.YearDay())
+	case patternWeekdayName:
+		if p.width == 4 {
+			return t.Format("Monday")
+		}
+		return t.Format("Mon")
+	case patternWeekdayNumber:
+		return strconv.Itoa(isoWeekday(t.Weekday()))
+	case patternWeekdayNumberFromSunday:
+		return strconv.Itoa(int(t.Weekday()))
+	case patternHour:
+		return p.formatInt(t.Hour())
+	case patternHour12:
+		hour := t.Hour() % 12
+		if hour == 0 {
+			hour = 12
+		}
+		return p.formatInt(hour)
+	case patternHourFrom1:
+		hour := t.Hour()
+		if hour == 0 {
+			hour = 24
+		}
+		return p.formatInt(hour)
+	case patternHour11:
+		return p.formatInt(t.Hour() % 12)
+	case patternMinute:
+		return p.formatInt(t.Minute())
+	case patternSecond:
+		return p.formatInt(t.Second())
+	case patternFraction:
+		digits := fmt.Sprintf("%09d", t.Nanosecond())
+		if p.width <= len(digits) {
+			return digits[:p.width]
+		}
+		return digits + strings.Repeat("0", p.width-len(digits))
+	case patternAMPM, patternZoneOffset:
+		return t.Format(p.text)
+	case patternZoneName:
+		return t.Format("MST")
+	case patternZoneID:
+		return t.Location().String()
+	case patternISOWeek:
+		_, week := t.ISOWeek()
+		return p.formatInt(week)
+	case patternISOYear:
+		year, _ := t.ISOWeek()
+		if p.width == 2 {
+			return fmt.Sprintf("%02d", year%100)
+		}
+		return fmt.Sprintf("%04d", year)
+	case patternQuarter:
+		quarter := (int(t.Month())-1)/3 + 1
+		switch p.width {
+		case 3:
+			return "Q" + strconv.Itoa(quarter)
+		case 4:
+			return quarterOrdinals[quarter-1] + " quarter"
+		}
+		return p.formatInt(quarter)
+	case patternWeekOfYearFromSunday:
+		return p.formatInt((t.YearDay() + 6 - int(t.Weekday())) / 7)
+	case patternWeekOfYearFromMonday:
+		return p.formatInt((t.YearDay() + 6 - (int(t.Weekday())+6)%7) / 7)
+	case patternEpoch:
+		return strconv.FormatInt(t.Unix(), 10)
+	default:
+		return ""
+	}
+}
+
+var quarterOrdinals = []string{"1st", "2nd", "3rd", "4th"}
+
+func (p patternToken) formatInt(value int) string {
+	if p.width <= 1 {
+		return strconv.Itoa(value)
+	}
+	if p.space {
+		return fmt.Sprintf("%*d", p.width, value)
+	}
+	return fmt.Sprintf("%0*d", p.width, value)
+}
+
+// regexp returns the expression matching the token; every field contains exactly one group.
+func (p patternToken) regexp() string {
+	switch p.kind {
+	case patternLiteral:
+		return regexp.QuoteMeta(p.text)
+	case patternMonth, patternWeekdayName:
+		if p.kind == patternMonth && p.width < 3 {
+			return p.numberRegexp(2)
+		}
+		return `([A-Za-z]+)`
+	case patternYear:
+		if p.width == 2 {
+			return `(\d{2})`
+		}
+		return `(\d{4})`
+	case patternISOYear:
+		if p.width == 2 {
+			return `(\d{2})`
+		}
+		return `(\d{4})`
+	case patternDayOfYear:
+		return p.numberRegexp(3)
+	case patternWeekdayNumber:
+		return `([1-7])`
+	case patternWeekdayNumberFromSunday:
+		return `([0-6])`
+	case patternAMPM:
+		return `([AaPp][Mm])`
+	case patternFraction:
+		return `(\d{` + strconv.Itoa(p.width) + `})`
+	case patternZoneOffset:
+		return `(Z|[+-]\d{2}(?::?\d{2})?)`
+	case patternZoneName, patternZoneID:
+		return `([A-Za-z_]+(?:/[A-Za-z0-9_+-]+)*)`
+	case patternQuarter:
+		switch p.width {
+		case 3:
+			return `[Qq]([1-4])`
+		case 4:
+			return `([1-4])(?:st|nd|rd|th) quarter`
+		}
+		return p.numberRegexp(1)
+	case patternEpoch:
+		return `(-?\d+)`
+	default:
+		return p.numberRegexp(2)
+	}
+}
+
+func (p patternToken) numberRegexp(maxDigits int) string {
+	if p.width <= 1 {
+		return `(\d{1,` + strconv.Itoa(maxDigits) + `})`
+	}
+	if p.space {
+		return `( *\d{1,` + strconv.Itoa(p.width) + `})`
+	}
+	return `(\d{` + strconv.Itoa(p.width) + `})`
+}
+
+func (p patternTokens) compile(pattern string) (*regexp.Regexp, error) {
+	if re, ok := patternRegexpCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	var builder strings.Builder
+	builder.WriteString("^")
+	for _, token := range p {
+		builder.WriteString(token.regexp())
+	}
+	builder.WriteString("$")
+	re, err := regexp.Compile(builder.String())
+	if err != nil {
+		return nil, err
+	}
+	if patternRegexpCacheLen.Add(1) > patternRegexpCacheSize {
+		patternRegexpCacheLen.Add(-1)
+		return re, nil
+	}
+	if _, loaded := patternRegexpCache.LoadOrStore(pattern, re); loaded {
+		patternRegexpCacheLen.Add(-1)
+	}
+	return re, nil
+}
+
+// parsable returns an error for fields that format fine but can not determine the date
+// on parse: week of year from Sunday or Monday (%U, %W) and the ISO year without ISO week.
+func (p patternTokens) parsable(ctx context.Context) error {
+	var hasISOYear, hasISOWeek bool
+	for _, token := range p {
+		switch token.kind {
+		case patternWeekOfYearFromSunday, patternWeekOfYearFromMonday:
+			return errors.Errorf(ctx, "week of year is not supported on parse, use the ISO week")
+		case patternISOYear:
+			hasISOYear = true
+		case patternISOWeek:
+			hasISOWeek = true
+		}
+	}
+	if hasISOYear && !hasISOWeek {
+		return errors.Errorf(ctx, "ISO year requires the ISO week on parse")
+	}
+	return nil
+}
+
+// parse parses value with the tokens. pattern is used for caching and error messages.
+func (p patternTokens) parse(
+	ctx context.Context,
+	pattern string,
+	value string,
+) (*stdtime.Time, error) {
+	if err := p.parsable(ctx); err != nil {
+		return nil, errors.Wrapf(ctx, err, "pattern '%s' can not be parsed", pattern)
+	}
+	re, err := p.compile(pattern)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "compile pattern '%s' failed", pattern)
+	}
+	matches := re.FindStringSubmatchIndex(value)
+	if matches == nil {
+		return nil, errors.Errorf(ctx, "parse '%s' with pattern '%s' failed", value, pattern)
+	}
+	fields := patternFields{
+		month:    1,
+		day:      1,
+		location: stdtime.UTC,
+	}
+	i := 1
+	for _, token := range p {
+		if token.kind == patternLiteral {
+			continue
+		}
+		start, end := matches[2*i], matches[2*i+1]
+		if err := fields.set(ctx, token, strings.TrimSpace(value[start:end])); err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse '%s' with pattern '%s' failed", value, pattern)
+		}
+		if token.kind == patternWeekdayName {
+			fields.weekdayName = value[start:end]
+			fields.weekdayPosition = start
+		}
+		i++
+	}
+	t, err := fields.time(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse '%s' with pattern '%s' failed", value, pattern)
+	}
+	return t, nil
+}
+
+// patternFields collects the values of a parsed pattern.
+type patternFields struct {
+	year       int
+	month      int
+	day        int
+	yearDay    int
+	weekday    int
+	hour       int
+	minute     int
+	second     int
+	nanosecond int
+	pm         *bool
+	isoWeek    int
+	isoYear    int
+	quarter    int
+	epoch      *int64
+	location   *stdtime.Location
+	hasMonth   bool
+	hasISOYear bool
+
+	// weekdayName and weekdayPosition are set if the weekday is given by name
+	weekdayName     string
+	weekdayPosition int
+}
+
+func (f *patternFields) set(ctx context.Context, token patternToken, value string) error {
+	switch token.kind {
+	case patternMonth:
+		if token.width >= 3 {
+			month, err := parseMonthName(ctx, value, token.width == 3)
+			if err != nil {
+				return errors.Wrapf(ctx, err, "parse month failed")
+			}
+			f.month = int(month)
+			f.hasMonth = true
+			return nil
+		}
+	case patternWeekdayName:
+		weekday, err := parseWeekdayName(ctx, value, token.width == 3)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "parse weekday failed")
+		}
+		f.weekday = isoWeekday(weekday)
+		return nil
+	case patternAMPM:
+		pm := strings.EqualFold(value, "pm")
+		f.pm = &pm
+		return nil
+	case patternZoneOffset:
+		location, err := parseZoneOffset(ctx, value)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "parse zone offset failed")
+		}
+		f.location = location
+		return nil
+	case patternZoneName:
+		if value == "UTC" || value == "GMT" || value == "Z" {
+			f.location = stdtime.UTC
+			return nil
+		}
+		fallthrough
+	case patternZoneID:
+		location, err := LoadLocation(ctx, value)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "load location failed")
+		}
+		f.location = location
+		return nil
+	case patternEpoch:
+		epoch, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "parse epoch failed")
+		}
+		f.epoch = &epoch
+		return nil
+	case patternFraction:
+		digits := value
+		if len(digits) > 9 {
+			digits = digits[:9]
+		}
+		nanosecond, err := strconv.Atoi(digits + strings.Repeat("0", 9-len(digits)))
+		if err != nil {
+			return errors.Wrapf(ctx, err, "parse fraction failed")
+		}
+		f.nanosecond = nanosecond
+		return nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "parse number '%s' failed", value)
+	}
+	switch token.kind {
+	case patternYear:
+		if token.width == 2 {
+			number = twoDigitYear(number)
+		}
+		f.year = number
+	case patternISOYear:
+		if token.width == 2 {
+			number = twoDigitYear(number)
+		}
+		f.isoYear = number
+		f.hasISOYear = true
+	case patternMonth:
+		f.month = number
+		f.hasMonth = true
+	case patternDay:
+		f.day = number
+	case patternDayOfYear:
+		f.yearDay = number
+	case patternWeekdayNumber:
+		f.weekday = number
+	case patternWeekdayNumberFromSunday:
+		f.weekday = isoWeekday(stdtime.Weekday(number))
+	case patternHour, patternHour12, patternHour11:
+		f.hour = number
+	case patternHourFrom1:
+		f.hour = number % 24
+	case patternMinute:
+		f.minute = number
+	case patternSecond:
+		f.second = number
+	case patternISOWeek:
+		f.isoWeek = number
+	case patternQuarter:
+		f.quarter = number
+	}
+	return nil
+}
+
+// time returns the time of the fields. A weekday name that does not match the date
+// is reported as ParseError of input.
+func (f patternFields) time(ctx context.Context, input string) (*stdtime.Time, error) {
+	if f.epoch != nil {
+		t := stdtime.Unix(*f.epoch, 0)
+		return &t, nil
+	}
+	if f.pm != nil {
+		if f.hour < 1 || f.hour > 12 {
+			return nil, errors.Errorf(ctx, "hour %d out of range for AM/PM", f.hour)
+		}
+		f.hour = f.hour % 12
+		if *f.pm {
+			f.hour += 12
+		}
+	}
+	if f.hour > 23 || f.minute > 59 || f.second > 59 {
+		return nil, errors.Errorf(ctx, "time %02d:%02d:%02d out of range", f.hour, f.minute, f.second)
+	}
+	var t stdtime.Time
+	switch {
+	case f.isoWeek > 0:
+		year := f.year
+		if f.hasISOYear {
+			year = f.isoYear
+		}
+		weekday := f.weekday
+		if weekday == 0 {
+			weekday = 1
+		}
+		if f.isoWeek > isoWeeksInYear(year) {
+			return nil, errors.Errorf(ctx, "iso week %d out of range", f.isoWeek)
+		}
+		jan4 := stdtime.Date(year, stdtime.January, 4, 0, 0, 0, 0, stdtime.UTC)
+		day := 4 - isoWeekday(jan4.Weekday()) + 1 + (f.isoWeek-1)*7 + weekday - 1
+		date := stdtime.Date(year, stdtime.January, day, 0, 0, 0, 0, stdtime.UTC)
+		t = stdtime.Date(
+			date.Year(), date.Month(), date.Day(),
+			f.hour, f.minute, f.second, f.nanosecond, f.location,
+		)
+	case f.yearDay > 0:
+		if f.yearDay > daysInYear(f.year) {
+			return nil, errors.Errorf(ctx, "day of year %d out of range", f.yearDay)
+		}
+		t = stdtime.Date(
+			f.year, stdtime.January, f.yearDay,
+			f.hour, f.minute, f.second, f.nanosecond, f.location,
+		)
+	default:
+		month := f.month
+		if !f.hasMonth && f.quarter > 0 {
+			month = (f.quarter-1)*3 + 1
+		}
+		if month < 1 || month > 12 {
+			return nil, errors.Errorf(ctx, "month %d out of range", month)
+		}
+		if f.day < 1 || f.day > daysIn(stdtime.Month(month), f.year) {
+			return nil, errors.Errorf(ctx, "day %d out of range", f.day)
+		}
+		t = stdtime.Date(
+			f.year, stdtime.Month(month), f.day,
+			f.hour, f.minute, f.second, f.nanosecond, f.location,
+		)
+	}
+	if f.weekdayName != "" && isoWeekday(t.Weekday()) != f.weekday {
+		return nil, newParseError(
+			input,
+			f.weekdayPosition,
+			"weekday '%s' does not match %s, which is a %s",
+			f.weekdayName,
+			t.Format(stdtime.DateOnly),
+			t.Weekday(),
+		)
+	}
+	return &t, nil
+}
+
+// goLayout translates the tokens into a Go layout, if every token has a Go equivalent.
+func (p patternTokens) goLayout(ctx context.Context, pattern string) (Layout, error) {
+	var builder strings.Builder
+	for _, token := range p {
+		fragment, ok := token.goLayout(builder.String())
+		if !ok {
+			return "", errors.Errorf(
+				ctx,
+				"pattern '%s' can not be expressed as go layout",
+				pattern,
+			)
+		}
+		builder.WriteString(fragment)
+	}
+	return Layout(builder.String()), nil
+}
+
+func (p patternToken) goLayout(previous string) (string, bool) {
+	switch p.kind {
+	case patternLiteral:
+		return p.text, isGoLayoutSafeLiteral(p.text)
+	case patternYear:
+		if p.width == 2 {
+			return "06", true
+		}
+		return "2006", true
+	case patternMonth:
+		return map[int]string{1: "1", 2: "01", 3: "Jan", 4: "January"}[p.width], true
+	case patternDay:
+		switch {
+		case p.space:
+			return "_2", true
+		case p.width == 2:
+			return "02", true
+		}
+		return "2", true
+	case patternDayOfYear:
+		return "002", p.width == 3
+	case patternWeekdayName:
+		if p.width == 4 {
+			return "Monday", true
+		}
+		return "Mon", true
+	case patternHour:
+		return "15", p.width == 2 && !p.space
+	case patternHour12:
+		if p.width == 2 {
+			return "03", !p.space
+		}
+		return "3", true
+	case patternMinute:
+		if p.width == 2 {
+			return "04", true
+		}
+		return "4", true
+	case patternSecond:
+		if p.width == 2 {
+			return "05", true
+		}
+		return "5", true
+	case patternFraction:
+		ok := strings.HasSuffix(previous, ".") || strings.HasSuffix(previous, ",")
+		return strings.Repeat("0", p.width), ok && p.width <= 9
+	case patternAMPM, patternZoneOffset:
+		return p.text, true
+	case patternZoneName:
+		return "MST", true
+	default:
+		return "", false
+	}
+}
+
+// isGoLayoutSafeLiteral reports whether text would be copied unchanged by a Go layout.
+func isGoLayoutSafeLiteral(text string) bool {
+	if strings.ContainsAny(text, "0123456789") {
+		return false
+	}
+	for _, std := range []string{"Jan", "Mon", "MST", "PM", "pm", "_2", "Z0"} {
+		if strings.Contains(text, std) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseMonthName(ctx context.Context, value string, short bool) (stdtime.Month, error) {
+	for month := stdtime.January; month <= stdtime.December; month++ {
+		name := month.String()
+		if short {
+			name = name[:3]
+		}
+		if strings.EqualFold(name, value) {
+			return month, nil
+		}
+	}
+	return 0, errors.Errorf(ctx, "unknown month '%s'", value)
+}
+
+func parseWeekdayName(ctx context.Context, value string, short bool) (stdtime.Weekday, error) {
+	for weekday := stdtime.Sunday; weekday <= stdtime.Saturday; weekday++ {
+		name := weekday.String()
+		if short {
+			name = name[:3]
+		}
+		if strings.EqualFold(name, value) {
+			return weekday, nil
+		}
+	}
+	return 0, errors.Errorf(ctx, "unknown weekday '%s'", value)
+}
+
+func parseZoneOffset(ctx context.Context, value string) (*stdtime.Location, error) {
+	if value == "Z" {
+		return stdtime.UTC, nil
+	}
+	digits := strings.ReplaceAll(value[1:], ":", "")
+	hours, err := strconv.Atoi(digits[:2])
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse hours failed")
+	}
+	var minutes int
+	if len(digits) == 4 {
+		minutes, err = strconv.Atoi(digits[2:])
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse minutes failed")
+		}
+	}
+	if hours > 23 || minutes > 59 {
+		return nil, errors.Errorf(ctx, "zone offset '%s' out of range", value)
+	}
+	offset := hours*3600 + minutes*60
+	if value[0] == '-' {
+		offset = -offset
+	}
+	return stdtime.FixedZone("", offset), nil
+}
+
+// isoWeekday returns the ISO 8601 weekday number (Monday=1 .. Sunday=7).
+func isoWeekday(weekday stdtime.Weekday) int {
+	if weekday == stdtime.Sunday {
+		return 7
+	}
+	return int(weekday)
+}
+
+func isoWeeksInYear(year int) int {
+	_, week := stdtime.Date(year, stdtime.December, 28, 0, 0, 0, 0, stdtime.UTC).ISOWeek()
+	return week
+}
+
+func daysInYear(year int) int {
+	return stdtime.Date(year, stdtime.December, 31, 0, 0, 0, 0, stdtime.UTC).YearDay()
+}
+
+func daysIn(month stdtime.Month, year int) int {
+	return stdtime.Date(year, month+1, 0, 0, 0, 0, 0, stdtime.UTC).Day()
+}
+
+// twoDigitYear expands a two digit year the same way time.Parse does.
+func twoDigitYear(year int) int {
+	if year >= 69 {
+		return year + 1900
+	}
+	return year + 2000
+}
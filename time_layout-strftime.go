@@ -0,0 +1,190 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	stdtime "time"
+
+	"github.com/bborbe/errors"
+	libparse "github.com/bborbe/parse"
+)
+
+const strftimeLayoutPrefix = "strftime:"
+
+// StrftimeLayout is a C/Python strftime pattern like "%Y-%m-%d %H:%M".
+// Besides the POSIX directives it supports %j (day of year), %G/%g/%V (ISO year and week),
+// %u (ISO weekday), %q (quarter), %f/%L/%N (micro, milli and nano seconds), %:z and
+// the "-" flag to suppress padding (%-d). %U and %W, and %G or %g without %V,
+// only format; Parse rejects them because they do not determine the date.
+type StrftimeLayout string
+
+func (l StrftimeLayout) String() string {
+	return string(l)
+}
+
+// Layout returns a Layout that formats and parses with the strftime pattern
+// and can be used in Layouts.
+func (l StrftimeLayout) Layout() Layout {
+	return Layout(strftimeLayoutPrefix + l.String())
+}
+
+// GoLayout translates the pattern into an equivalent Go layout.
+// It fails if the pattern uses directives Go layouts can not express, like %V or %q.
+func (l StrftimeLayout) GoLayout(ctx context.Context) (Layout, error) {
+	tokens, err := l.tokens(ctx)
+	if err != nil {
+		return "", errors.Wrapf(ctx, err, "tokenize failed")
+	}
+	return tokens.goLayout(ctx, l.String())
+}
+
+func (l StrftimeLayout) Validate(ctx context.Context) error {
+	if _, err := l.tokens(ctx); err != nil {
+		return errors.Wrapf(ctx, err, "invalid strftime layout")
+	}
+	return nil
+}
+
+// Format returns t formatted with the pattern. Unknown directives are copied verbatim.
+func (l StrftimeLayout) Format(t stdtime.Time) string {
+	tokens, _ := l.tokens(context.Background())
+	return tokens.format(t)
+}
+
+func (l StrftimeLayout) Parse(ctx context.Context, value interface{}) (*stdtime.Time, error) {
+	str, err := libparse.ParseString(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse value failed")
+	}
+	tokens, err := l.tokens(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "tokenize failed")
+	}
+	return tokens.parse(ctx, strftimeLayoutPrefix+l.String(), str)
+}
+
+var strftimeDirectives = map[byte]patternTokens{
+	'a': {{kind: patternWeekdayName, width: 3}},
+	'A': {{kind: patternWeekdayName, width: 4}},
+	'b': {{kind: patternMonth, width: 3}},
+	'h': {{kind: patternMonth, width: 3}},
+	'B': {{kind: patternMonth, width: 4}},
+	'd': {{kind: patternDay, width: 2}},
+	'e': {{kind: patternDay, width: 2, space: true}},
+	'j': {{kind: patternDayOfYear, width: 3}},
+	'm': {{kind: patternMonth, width: 2}},
+	'y': {{kind: patternYear, width: 2}},
+	'Y': {{kind: patternYear, width: 4}},
+	'G': {{kind: patternISOYear, width: 4}},
+	'g': {{kind: patternISOYear, width: 2}},
+	'V': {{kind: patternISOWeek, width: 2}},
+	'U': {{kind: patternWeekOfYearFromSunday, width: 2}},
+	'W': {{kind: patternWeekOfYearFromMonday, width: 2}},
+	'u': {{kind: patternWeekdayNumber, width: 1}},
+	'w': {{kind: patternWeekdayNumberFromSunday, width: 1}},
+	'q': {{kind: patternQuarter, width: 1}},
+	'H': {{kind: patternHour, width: 2}},
+	'k': {{kind: patternHour, width: 2, space: true}},
+	'I': {{kind: patternHour12, width: 2}},
+	'l': {{kind: patternHour12, width: 2, space: true}},
+	'p': {{kind: patternAMPM, text: "PM"}},
+	'P': {{kind: patternAMPM, text: "pm"}},
+	'M': {{kind: patternMinute, width: 2}},
+	'S': {{kind: patternSecond, width: 2}},
+	'L': {{kind: patternFraction, width: 3}},
+	'f': {{kind: patternFraction, width: 6}},
+	'N': {{kind: patternFraction, width: 9}},
+	'z': {{kind: patternZoneOffset, text: "-0700"}},
+	'Z': {{kind: patternZoneName}},
+	's': {{kind: patternEpoch}},
+	'n': {{kind: patternLiteral, text: "\n"}},
+	't': {{kind: patternLiteral, text: "\t"}},
+	'%': {{kind: patternLiteral, text: "%"}},
+	'F': {
+		{kind: patternYear, width: 4},
+		{kind: patternLiteral, text: "-"},
+		{kind: patternMonth, width: 2},
+		{kind: patternLiteral, text: "-"},
+		{kind: patternDay, width: 2},
+	},
+	'D': {
+		{kind: patternMonth, width: 2},
+		{kind: patternLiteral, text: "/"},
+		{kind: patternDay, width: 2},
+		{kind: patternLiteral, text: "/"},
+		{kind: patternYear, width: 2},
+	},
+	'R': {
+		{kind: patternHour, width: 2},
+		{kind: patternLiteral, text: ":"},
+		{kind: patternMinute, width: 2},
+	},
+	'T': {
+		{kind: patternHour, width: 2},
+		{kind: patternLiteral, text: ":"},
+		{kind: patternMinute, width: 2},
+		{kind: patternLiteral, text: ":"},
+		{kind: patternSecond, width: 2},
+	},
+}
+
+// tokens splits the pattern into tokens. Unknown directives are kept as literals
+// and reported in the returned error.
+func (l StrftimeLayout) tokens(ctx context.Context) (patternTokens, error) {
+	var result patternTokens
+	var err error
+	pattern := l.String()
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '%' {
+			result = appendLiteral(result, pattern[i:i+1])
+			continue
+		}
+		start := i
+		i++
+		var noPadding bool
+		if i < len(pattern) && pattern[i] == '-' {
+			noPadding = true
+			i++
+		}
+		if i+1 < len(pattern) && pattern[i] == ':' && pattern[i+1] == 'z' {
+			i++
+			result = append(result, patternToken{kind: patternZoneOffset, text: "-07:00"})
+			continue
+		}
+		if i >= len(pattern) {
+			result = appendLiteral(result, pattern[start:])
+			err = errors.Errorf(ctx, "incomplete directive at end of '%s'", pattern)
+			break
+		}
+		directive, ok := strftimeDirectives[pattern[i]]
+		if !ok {
+			result = appendLiteral(result, pattern[start:i+1])
+			err = errors.Errorf(ctx, "unknown directive '%s' in '%s'", pattern[start:i+1], pattern)
+			continue
+		}
+		for _, token := range directive {
+			if noPadding && token.width == 2 {
+				token.width = 1
+				token.space = false
+			}
+			if token.kind == patternLiteral {
+				result = appendLiteral(result, token.text)
+				continue
+			}
+			result = append(result, token)
+		}
+	}
+	return result, err
+}
+
+// appendLiteral appends text to the last token if it is a literal.
+func appendLiteral(tokens patternTokens, text string) patternTokens {
+	if len(tokens) > 0 && tokens[len(tokens)-1].kind == patternLiteral {
+		tokens[len(tokens)-1].text += text
+		return tokens
+	}
+	return append(tokens, patternToken{kind: patternLiteral, text: text})
+}
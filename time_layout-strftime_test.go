@@ -0,0 +1,249 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("StrftimeLayout", func() {
+	var ctx context.Context
+	var input time.Time
+	BeforeEach(func() {
+		ctx = context.Background()
+		input = time.Date(2024, time.March, 5, 14, 7, 9, 123456789, time.UTC)
+	})
+	DescribeTable("Format",
+		func(layout libtime.StrftimeLayout, expected string) {
+			Expect(layout.Format(input)).To(Equal(expected))
+		},
+		Entry("date time", libtime.StrftimeLayout("%Y-%m-%d %H:%M"), "2024-03-05 14:07"),
+		Entry("F and T", libtime.StrftimeLayout("%FT%T"), "2024-03-05T14:07:09"),
+		Entry("names", libtime.StrftimeLayout("%a %A %b %B"), "Tue Tuesday Mar March"),
+		Entry("two digit year", libtime.StrftimeLayout("%D"), "03/05/24"),
+		Entry("space padded day", libtime.StrftimeLayout("%e"), " 5"),
+		Entry("no padding", libtime.StrftimeLayout("%-d.%-m."), "5.3."),
+		Entry("12 hour", libtime.StrftimeLayout("%I:%M %p"), "02:07 PM"),
+		Entry("day of year", libtime.StrftimeLayout("%j"), "065"),
+		Entry("iso week", libtime.StrftimeLayout("%G-W%V-%u"), "2024-W10-2"),
+		Entry("quarter", libtime.StrftimeLayout("%Y-Q%q"), "2024-Q1"),
+		Entry("week of year", libtime.StrftimeLayout("%U %W %w"), "09 10 2"),
+		Entry("milli", libtime.StrftimeLayout("%S.%L"), "09.123"),
+		Entry("micro", libtime.StrftimeLayout("%S.%f"), "09.123456"),
+		Entry("nano", libtime.StrftimeLayout("%S.%N"), "09.123456789"),
+		Entry("offset", libtime.StrftimeLayout("%z %:z %Z"), "+0000 +00:00 UTC"),
+		Entry("epoch", libtime.StrftimeLayout("%s"), "1709647629"),
+		Entry("percent", libtime.StrftimeLayout("100%%"), "100%"),
+		Entry("unknown directive", libtime.StrftimeLayout("%Y %Q"), "2024 %Q"),
+	)
+	DescribeTable("Parse",
+		func(layout libtime.StrftimeLayout, value string, expected string, expectError bool) {
+			result, err := layout.Parse(ctx, value)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(result).NotTo(BeNil())
+			Expect(result.Format(time.RFC3339Nano)).To(Equal(expected))
+		},
+		Entry(
+			"date time",
+			libtime.StrftimeLayout("%Y-%m-%d %H:%M"),
+			"2024-03-05 14:07",
+			"2024-03-05T14:07:00Z",
+			false,
+		),
+		Entry(
+			"names",
+			libtime.StrftimeLayout("%a, %d %b %Y"),
+			"tue, 05 MAR 2024",
+			"2024-03-05T00:00:00Z",
+			false,
+		),
+		Entry(
+			"12 hour pm",
+			libtime.StrftimeLayout("%Y-%m-%d %I:%M %p"),
+			"2024-03-05 02:07 pm",
+			"2024-03-05T14:07:00Z",
+			false,
+		),
+		Entry(
+			"12 hour midnight",
+			libtime.StrftimeLayout("%Y-%m-%d %I:%M %p"),
+			"2024-03-05 12:07 AM",
+			"2024-03-05T00:07:00Z",
+			false,
+		),
+		Entry(
+			"day of year",
+			libtime.StrftimeLayout("%Y-%j"),
+			"2024-065",
+			"2024-03-05T00:00:00Z",
+			false,
+		),
+		Entry(
+			"iso week",
+			libtime.StrftimeLayout("%G-W%V-%u"),
+			"2025-W01-1",
+			"2024-12-30T00:00:00Z",
+			false,
+		),
+		Entry(
+			"week of year from sunday",
+			libtime.StrftimeLayout("%Y-%U-%w"),
+			"2024-09-2",
+			"",
+			true,
+		),
+		Entry(
+			"week of year from monday",
+			libtime.StrftimeLayout("%Y-%W-%u"),
+			"2024-09-2",
+			"",
+			true,
+		),
+		Entry(
+			"iso year without iso week",
+			libtime.StrftimeLayout("%G-%m-%d"),
+			"2024-03-05",
+			"",
+			true,
+		),
+		Entry(
+			"quarter",
+			libtime.StrftimeLayout("%Y-Q%q"),
+			"2024-Q3",
+			"2024-07-01T00:00:00Z",
+			false,
+		),
+		Entry(
+			"fraction",
+			libtime.StrftimeLayout("%H:%M:%S.%f"),
+			"14:07:09.123456",
+			"0000-01-01T14:07:09.123456Z",
+			false,
+		),
+		Entry(
+			"offset",
+			libtime.StrftimeLayout("%Y-%m-%dT%H:%M:%S%z"),
+			"2024-03-05T14:07:09+0100",
+			"2024-03-05T14:07:09+01:00",
+			false,
+		),
+		Entry(
+			"zone name",
+			libtime.StrftimeLayout("%Y-%m-%d %H:%M %Z"),
+			"2024-03-05 14:07 Europe/Berlin",
+			"2024-03-05T14:07:00+01:00",
+			false,
+		),
+		Entry("epoch", libtime.StrftimeLayout("%s"), "1709647629", "2024-03-05T14:07:09Z", false),
+		Entry("mismatch", libtime.StrftimeLayout("%Y-%m-%d"), "05.03.2024", "", true),
+		Entry("invalid day", libtime.StrftimeLayout("%Y-%m-%d"), "2024-02-30", "", true),
+		Entry("invalid day of year", libtime.StrftimeLayout("%Y-%j"), "2023-366", "", true),
+		Entry("unknown directive", libtime.StrftimeLayout("%Y %Q"), "2024 %Q", "", true),
+		Entry(
+			"weekday mismatch",
+			libtime.StrftimeLayout("%a %Y-%m-%d"),
+			"Tue 2024-03-04",
+			"",
+			true,
+		),
+		Entry(
+			"weekday number",
+			libtime.StrftimeLayout("%Y-%m-%d %u"),
+			"2024-03-05 2",
+			"2024-03-05T00:00:00Z",
+			false,
+		),
+	)
+	It("reports a weekday mismatch as ParseError", func() {
+		_, err := libtime.StrftimeLayout("%Y-%m-%d %A").Parse(ctx, "2024-03-04 Tuesday")
+		Expect(err).NotTo(BeNil())
+		var parseError *libtime.ParseError
+		Expect(stderrors.As(err, &parseError)).To(BeTrue())
+		Expect(parseError.Input).To(Equal("2024-03-04 Tuesday"))
+		Expect(parseError.Position).To(Equal(11))
+		Expect(parseError.Reason).To(ContainSubstring("Monday"))
+	})
+	It("parses more distinct patterns than the regexp cache holds", func() {
+		for i := 0; i < 300; i++ {
+			layout := libtime.StrftimeLayout(fmt.Sprintf("%%Y-%%m-%%d x%d", i))
+			result, err := layout.Parse(ctx, fmt.Sprintf("2024-03-05 x%d", i))
+			Expect(err).To(BeNil())
+			Expect(result.Format(time.DateOnly)).To(Equal("2024-03-05"))
+		}
+	})
+	DescribeTable("GoLayout",
+		func(layout libtime.StrftimeLayout, expected libtime.Layout, expectError bool) {
+			result, err := layout.GoLayout(ctx)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expected))
+		},
+		Entry(
+			"date time",
+			libtime.StrftimeLayout("%Y-%m-%d %H:%M"),
+			libtime.Layout("2006-01-02 15:04"),
+			false,
+		),
+		Entry(
+			"rfc3339",
+			libtime.StrftimeLayout("%FT%T%:z"),
+			libtime.Layout("2006-01-02T15:04:05-07:00"),
+			false,
+		),
+		Entry("fraction", libtime.StrftimeLayout("%T.%L"), libtime.Layout("15:04:05.000"), false),
+		Entry("day of year", libtime.StrftimeLayout("%Y-%j"), libtime.Layout("2006-002"), false),
+		Entry("iso week", libtime.StrftimeLayout("%G-W%V"), libtime.Layout(""), true),
+		Entry("quarter", libtime.StrftimeLayout("%q"), libtime.Layout(""), true),
+		Entry("unsafe literal", libtime.StrftimeLayout("%Y Jan"), libtime.Layout(""), true),
+	)
+	DescribeTable("Validate",
+		func(layout libtime.StrftimeLayout, expectError bool) {
+			err := layout.Validate(ctx)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+			}
+		},
+		Entry("valid", libtime.StrftimeLayout("%Y-%m-%d"), false),
+		Entry("unknown directive", libtime.StrftimeLayout("%Q"), true),
+		Entry("incomplete", libtime.StrftimeLayout("%Y-%"), true),
+	)
+	Context("Layout", func() {
+		It("formats with the strftime pattern", func() {
+			Expect(libtime.StrftimeLayout("%d.%m.%Y").Layout().Format(input)).To(Equal("05.03.2024"))
+		})
+		It("parses with the strftime pattern", func() {
+			result, err := libtime.StrftimeLayout("%d.%m.%Y").Layout().Parse(ctx, "05.03.2024")
+			Expect(err).To(BeNil())
+			Expect(result.Format(time.DateOnly)).To(Equal("2024-03-05"))
+		})
+		It("is used by Layouts.Parse", func() {
+			layouts := libtime.Layouts{
+				libtime.RFC3339,
+				libtime.StrftimeLayout("%d.%m.%Y %H:%M").Layout(),
+			}
+			result, err := layouts.Parse(ctx, "05.03.2024 14:07")
+			Expect(err).To(BeNil())
+			Expect(result.Format(time.RFC3339)).To(Equal("2024-03-05T14:07:00Z"))
+		})
+	})
+})
@@ -7,6 +7,7 @@ package time
 import (
 	"context"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bborbe/errors"
@@ -19,8 +20,11 @@ const (
 	MilliLayout  Layout = "milli"
 	MicroLayout  Layout = "micro"
 	NanoLayout   Layout = "nano"
-	RFC3339      Layout = time.RFC3339
-	RFC3339Nano  Layout = time.RFC3339Nano
+	// AutoEpochLayout parses epoch numbers in seconds, milli, micro or nano
+	// depending on their magnitude, see ParseAutoEpoch.
+	AutoEpochLayout Layout = "auto"
+	RFC3339         Layout = time.RFC3339
+	RFC3339Nano     Layout = time.RFC3339Nano
 )
 
 type Layouts []Layout
@@ -34,7 +38,8 @@ func (l Layouts) Parse(ctx context.Context, value interface{}) (*time.Time, erro
 	return nil, errors.Errorf(ctx, "parse '%v' with any layouts failed", value)
 }
 
-// Layout is one of (millis,seconds,nano) or any time.Layout like time.RFC3339Nano
+// Layout is one of (millis,seconds,nano,auto) or any time.Layout like time.RFC3339Nano.
+// Layouts created by StrftimeLayout.Layout and ICULayout.Layout are supported as well.
 type Layout string
 
 func (l Layout) String() string {
@@ -51,7 +56,15 @@ func (l Layout) Format(time time.Time) string {
 		return strconv.FormatInt(time.UnixMicro(), 10)
 	case NanoLayout:
 		return strconv.FormatInt(time.UnixNano(), 10)
+	case AutoEpochLayout:
+		return FormatAutoEpoch(time)
 	default:
+		if strftime, ok := strings.CutPrefix(l.String(), strftimeLayoutPrefix); ok {
+			return StrftimeLayout(strftime).Format(time)
+		}
+		if icu, ok := strings.CutPrefix(l.String(), icuLayoutPrefix); ok {
+			return ICULayout(icu).Format(time)
+		}
 		return time.Format(l.String())
 	}
 }
@@ -86,7 +99,15 @@ func (l Layout) Parse(ctx context.Context, value interface{}) (*time.Time, error
 		}
 		t := time.Unix(i/int64(time.Second), i%int64(time.Second))
 		return &t, nil
+	case AutoEpochLayout:
+		return ParseAutoEpoch(ctx, value)
 	default:
+		if strftime, ok := strings.CutPrefix(l.String(), strftimeLayoutPrefix); ok {
+			return StrftimeLayout(strftime).Parse(ctx, value)
+		}
+		if icu, ok := strings.CutPrefix(l.String(), icuLayoutPrefix); ok {
+			return ICULayout(icu).Parse(ctx, value)
+		}
 		switch v := value.(type) {
 		case string:
 			t, err := time.Parse(l.String(), v)
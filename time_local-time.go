@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	stdtime "time"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/validation"
+)
+
+// LocalTimeResolver defines how a wall clock is resolved to an instant if daylight saving
+// makes it ambiguous, like 02:30 in Europe/Berlin when clocks fall back from 03:00 to 02:00,
+// or nonexistent, like 02:30 when clocks spring forward from 02:00 to 03:00.
+type LocalTimeResolver int
+
+const (
+	// LocalTimeResolverShiftForward picks the earlier instant of ambiguous times and moves
+	// nonexistent times forward by the length of the gap (02:30 => 03:30).
+	LocalTimeResolverShiftForward LocalTimeResolver = iota
+	// LocalTimeResolverEarlier picks the earlier instant of ambiguous times and moves
+	// nonexistent times back by the length of the gap (02:30 => 01:30).
+	LocalTimeResolverEarlier
+	// LocalTimeResolverLater picks the later instant of ambiguous times and moves
+	// nonexistent times forward by the length of the gap (02:30 => 03:30).
+	LocalTimeResolverLater
+	// LocalTimeResolverReject returns an error for ambiguous and nonexistent times.
+	LocalTimeResolverReject
+)
+
+// IsAmbiguous reports whether the wall clock of localWallClock occurs twice in location,
+// because clocks fall back. The location of localWallClock is ignored.
+func IsAmbiguous(localWallClock stdtime.Time, location *stdtime.Location) bool {
+	return newLocalTime(localWallClock, location).ambiguous()
+}
+
+// IsNonexistent reports whether the wall clock of localWallClock is skipped in location,
+// because clocks spring forward. The location of localWallClock is ignored.
+func IsNonexistent(localWallClock stdtime.Time, location *stdtime.Location) bool {
+	return newLocalTime(localWallClock, location).nonexistent()
+}
+
+// NewDateTimeWithResolver is NewDateTime with explicit handling of ambiguous
+// and nonexistent local times.
+func NewDateTimeWithResolver(
+	ctx context.Context,
+	year int,
+	month stdtime.Month,
+	day, hour, min, sec, nsec int,
+	loc *stdtime.Location,
+	resolver LocalTimeResolver,
+) (*DateTime, error) {
+	wall := stdtime.Date(year, month, day, hour, min, sec, nsec, stdtime.UTC)
+	result, err := newLocalTime(wall, loc).resolve(ctx, resolver)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "resolve date time failed")
+	}
+	return DateTime(result).Ptr(), nil
+}
+
+// NewDateWithResolver is NewDate with explicit handling of ambiguous
+// and nonexistent local times.
+func NewDateWithResolver(
+	ctx context.Context,
+	year int,
+	month stdtime.Month,
+	day, hour, min, sec, nsec int,
+	loc *stdtime.Location,
+	resolver LocalTimeResolver,
+) (*Date, error) {
+	result, err := NewDateTimeWithResolver(ctx, year, month, day, hour, min, sec, nsec, loc, resolver)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "resolve date failed")
+	}
+	return Date(*result).Ptr(), nil
+}
+
+// NewUnixTimeWithResolver is NewUnixTime with explicit handling of ambiguous
+// and nonexistent local times.
+func NewUnixTimeWithResolver(
+	ctx context.Context,
+	year int,
+	month stdtime.Month,
+	day, hour, min, sec, nsec int,
+	loc *stdtime.Location,
+	resolver LocalTimeResolver,
+) (*UnixTime, error) {
+	result, err := NewDateTimeWithResolver(ctx, year, month, day, hour, min, sec, nsec, loc, resolver)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "resolve unix time failed")
+	}
+	return UnixTime(*result).Ptr(), nil
+}
+
+// localTime is a wall clock with the instants it can stand for in location.
+// For nonexistent wall clocks earlier is shifted back and later shifted forward by the gap.
+type localTime struct {
+	wall     stdtime.Time
+	location *stdtime.Location
+	earlier  stdtime.Time
+	later    stdtime.Time
+	// instants is the number of instants showing the wall clock: 0, 1 or 2
+	instants int
+}
+
+// newLocalTime resolves the wall clock of wall in location. A nil location is treated as UTC.
+func newLocalTime(wall stdtime.Time, location *stdtime.Location) localTime {
+	location = locationOrUTC(location)
+	year, month, day := wall.Date()
+	wall = stdtime.Date(
+		year, month, day,
+		wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(),
+		stdtime.UTC,
+	)
+	// daylight saving transitions are rare enough that at most one happens within a day
+	offsetBefore := zoneOffset(wall.Add(-24*stdtime.Hour), location)
+	offsetAfter := zoneOffset(wall.Add(24*stdtime.Hour), location)
+	before := wall.Add(-offsetBefore).In(location)
+	after := wall.Add(-offsetAfter).In(location)
+	result := localTime{wall: wall, location: location}
+	beforeValid := sameWallClock(before, wall)
+	afterValid := sameWallClock(after, wall)
+	switch {
+	case beforeValid && afterValid && !before.Equal(after):
+		result.instants = 2
+		result.earlier, result.later = before, after
+		if after.Before(before) {
+			result.earlier, result.later = after, before
+		}
+	case beforeValid:
+		result.instants = 1
+		result.earlier, result.later = before, before
+	case afterValid:
+		result.instants = 1
+		result.earlier, result.later = after, after
+	default:
+		result.earlier, result.later = after, before
+	}
+	return result
+}
+
+func (l localTime) ambiguous() bool {
+	return l.instants == 2
+}
+
+func (l localTime) nonexistent() bool {
+	return l.instants == 0
+}
+
+func (l localTime) resolve(ctx context.Context, resolver LocalTimeResolver) (stdtime.Time, error) {
+	switch resolver {
+	case LocalTimeResolverShiftForward:
+		if l.nonexistent() {
+			return l.later, nil
+		}
+		return l.earlier, nil
+	case LocalTimeResolverEarlier:
+		return l.earlier, nil
+	case LocalTimeResolverLater:
+		return l.later, nil
+	case LocalTimeResolverReject:
+		if l.ambiguous() {
+			return stdtime.Time{}, errors.Wrapf(
+				ctx,
+				validation.Error,
+				"local time %s is ambiguous in %s",
+				l.wall.Format(stdtime.DateTime),
+				l.location,
+			)
+		}
+		if l.nonexistent() {
+			return stdtime.Time{}, l.nonexistentError(ctx)
+		}
+		return l.earlier, nil
+	default:
+		return stdtime.Time{}, errors.Errorf(ctx, "unknown local time resolver %d", resolver)
+	}
+}
+
+func (l localTime) nonexistentError(ctx context.Context) error {
+	return errors.Wrapf(
+		ctx,
+		validation.Error,
+		"local time %s does not exist in %s",
+		l.wall.Format(stdtime.DateTime),
+		l.location,
+	)
+}
+
+// zoneOffset returns the offset of location at the instant wall would have in UTC.
+func zoneOffset(wall stdtime.Time, location *stdtime.Location) stdtime.Duration {
+	_, offset := wall.In(location).Zone()
+	return stdtime.Duration(offset) * stdtime.Second
+}
+
+// sameWallClock reports whether t shows the date and clock of wall.
+func sameWallClock(t stdtime.Time, wall stdtime.Time) bool {
+	y1, m1, d1 := t.Date()
+	y2, m2, d2 := wall.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2 &&
+		t.Hour() == wall.Hour() && t.Minute() == wall.Minute() &&
+		t.Second() == wall.Second() && t.Nanosecond() == wall.Nanosecond()
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("LocalTime", func() {
+	var ctx context.Context
+	var berlin *time.Location
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		berlin, err = time.LoadLocation("Europe/Berlin")
+		Expect(err).To(BeNil())
+	})
+	DescribeTable("IsAmbiguous and IsNonexistent",
+		func(wall string, expectedAmbiguous bool, expectedNonexistent bool) {
+			t, err := time.Parse(time.DateTime, wall)
+			Expect(err).To(BeNil())
+			Expect(libtime.IsAmbiguous(t, berlin)).To(Equal(expectedAmbiguous))
+			Expect(libtime.IsNonexistent(t, berlin)).To(Equal(expectedNonexistent))
+		},
+		Entry("regular", "2024-07-01 02:30:00", false, false),
+		Entry("spring forward gap", "2024-03-31 02:30:00", false, true),
+		Entry("spring forward gap start", "2024-03-31 02:00:00", false, true),
+		Entry("spring forward after gap", "2024-03-31 03:00:00", false, false),
+		Entry("fall back overlap", "2024-10-27 02:30:00", true, false),
+		Entry("fall back overlap start", "2024-10-27 02:00:00", true, false),
+		Entry("fall back after overlap", "2024-10-27 03:00:00", false, false),
+	)
+	It("ignores the location of the wall clock", func() {
+		wall := time.Date(2024, time.October, 27, 2, 30, 0, 0, time.FixedZone("X", 5*3600))
+		Expect(libtime.IsAmbiguous(wall, berlin)).To(BeTrue())
+		Expect(libtime.IsAmbiguous(wall, time.UTC)).To(BeFalse())
+		Expect(libtime.IsNonexistent(wall, nil)).To(BeFalse())
+	})
+	DescribeTable("NewDateTimeWithResolver",
+		func(
+			month time.Month,
+			day int,
+			resolver libtime.LocalTimeResolver,
+			expected string,
+			expectError bool,
+		) {
+			result, err := libtime.NewDateTimeWithResolver(
+				ctx, 2024, month, day, 2, 30, 0, 0, berlin, resolver,
+			)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(result.String()).To(Equal(expected))
+		},
+		Entry(
+			"regular shift forward",
+			time.July,
+			1,
+			libtime.LocalTimeResolverShiftForward,
+			"2024-07-01T02:30:00+02:00",
+			false,
+		),
+		Entry(
+			"regular reject",
+			time.July,
+			1,
+			libtime.LocalTimeResolverReject,
+			"2024-07-01T02:30:00+02:00",
+			false,
+		),
+		Entry(
+			"gap shift forward",
+			time.March,
+			31,
+			libtime.LocalTimeResolverShiftForward,
+			"2024-03-31T03:30:00+02:00",
+			false,
+		),
+		Entry(
+			"gap earlier",
+			time.March,
+			31,
+			libtime.LocalTimeResolverEarlier,
+			"2024-03-31T01:30:00+01:00",
+			false,
+		),
+		Entry(
+			"gap later",
+			time.March,
+			31,
+			libtime.LocalTimeResolverLater,
+			"2024-03-31T03:30:00+02:00",
+			false,
+		),
+		Entry("gap reject", time.March, 31, libtime.LocalTimeResolverReject, "", true),
+		Entry(
+			"overlap shift forward",
+			time.October,
+			27,
+			libtime.LocalTimeResolverShiftForward,
+			"2024-10-27T02:30:00+02:00",
+			false,
+		),
+		Entry(
+			"overlap earlier",
+			time.October,
+			27,
+			libtime.LocalTimeResolverEarlier,
+			"2024-10-27T02:30:00+02:00",
+			false,
+		),
+		Entry(
+			"overlap later",
+			time.October,
+			27,
+			libtime.LocalTimeResolverLater,
+			"2024-10-27T02:30:00+01:00",
+			false,
+		),
+		Entry("overlap reject", time.October, 27, libtime.LocalTimeResolverReject, "", true),
+		Entry("unknown resolver", time.July, 1, libtime.LocalTimeResolver(42), "", true),
+	)
+	It("resolves Date and UnixTime", func() {
+		date, err := libtime.NewDateWithResolver(
+			ctx, 2024, time.October, 27, 2, 30, 0, 0, berlin, libtime.LocalTimeResolverLater,
+		)
+		Expect(err).To(BeNil())
+		Expect(date.Time().UTC().Format(time.RFC3339)).To(Equal("2024-10-27T01:30:00Z"))
+		unixTime, err := libtime.NewUnixTimeWithResolver(
+			ctx, 2024, time.October, 27, 2, 30, 0, 0, berlin, libtime.LocalTimeResolverEarlier,
+		)
+		Expect(err).To(BeNil())
+		Expect(unixTime.UTC().String()).To(Equal("2024-10-27T00:30:00Z"))
+		_, err = libtime.NewUnixTimeWithResolver(
+			ctx, 2024, time.March, 31, 2, 30, 0, 0, berlin, libtime.LocalTimeResolverReject,
+		)
+		Expect(err).NotTo(BeNil())
+	})
+	It("measures shifts across the fall back transition", func() {
+		from, err := libtime.NewDateTimeWithResolver(
+			ctx, 2024, time.October, 27, 0, 0, 0, 0, berlin, libtime.LocalTimeResolverReject,
+		)
+		Expect(err).To(BeNil())
+		until, err := libtime.NewDateTimeWithResolver(
+			ctx, 2024, time.October, 27, 6, 0, 0, 0, berlin, libtime.LocalTimeResolverReject,
+		)
+		Expect(err).To(BeNil())
+		Expect(until.Sub(from)).To(Equal(7 * libtime.Hour))
+	})
+})
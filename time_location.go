@@ -0,0 +1,141 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"strings"
+	stdtime "time"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
+)
+
+// NewLocation returns a Location for location.
+func NewLocation(location *stdtime.Location) Location {
+	return Location{location: location}
+}
+
+// Location is a time zone that can be used in config structs.
+// It marshals as its IANA name like "Europe/Berlin". The zero Location is empty
+// and behaves like UTC.
+type Location struct {
+	location *stdtime.Location
+}
+
+var _ HasLocation = Location{}
+
+var _ encoding.TextMarshaler = Location{}
+
+var _ encoding.TextUnmarshaler = (*Location)(nil)
+
+var _ yaml.Marshaler = Location{}
+
+var _ yaml.Unmarshaler = (*Location)(nil)
+
+var _ sql.Scanner = (*Location)(nil)
+
+var _ driver.Valuer = Location{}
+
+// Location returns the time.Location. The zero Location returns time.UTC.
+func (l Location) Location() *stdtime.Location {
+	return locationOrUTC(l.location)
+}
+
+// IsZero reports whether no location is set.
+func (l Location) IsZero() bool {
+	return l.location == nil
+}
+
+// String returns the name of the location like "Europe/Berlin"
+// or an empty string for the zero Location.
+func (l Location) String() string {
+	if l.location == nil {
+		return ""
+	}
+	return l.location.String()
+}
+
+func (l Location) Validate(ctx context.Context) error {
+	if l.IsZero() {
+		return errors.Wrapf(ctx, validation.Error, "location is empty")
+	}
+	return nil
+}
+
+func (l Location) Ptr() *Location {
+	return &l
+}
+
+func (l Location) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+func (l *Location) UnmarshalText(b []byte) error {
+	str := string(b)
+	if len(str) == 0 {
+		*l = Location{}
+		return nil
+	}
+	location, err := LoadLocation(context.Background(), str)
+	if err != nil {
+		return errors.Wrapf(context.Background(), err, "load location failed")
+	}
+	*l = NewLocation(location)
+	return nil
+}
+
+func (l Location) MarshalJSON() ([]byte, error) {
+	if l.IsZero() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(l.String())
+}
+
+func (l *Location) UnmarshalJSON(b []byte) error {
+	str := strings.Trim(string(b), `"`)
+	if str == "null" {
+		str = ""
+	}
+	return l.UnmarshalText([]byte(str))
+}
+
+// MarshalYAML implements yaml.Marshaler. The zero Location is written as null like in MarshalJSON.
+func (l Location) MarshalYAML() (interface{}, error) {
+	return marshalYAMLText(l)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. Empty values and "null" result in the zero Location.
+func (l *Location) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLText(node, l)
+}
+
+// Scan implements sql.Scanner for location names. NULL scans into the zero Location.
+func (l *Location) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*l = Location{}
+		return nil
+	case []byte:
+		return l.UnmarshalText(v)
+	case string:
+		return l.UnmarshalText([]byte(v))
+	default:
+		return errors.Errorf(context.Background(), "can not scan %T into location", src)
+	}
+}
+
+// Value implements driver.Valuer. The zero Location is stored as NULL.
+func (l Location) Value() (driver.Value, error) {
+	if l.IsZero() {
+		return nil, nil
+	}
+	return l.String(), nil
+}
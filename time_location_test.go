@@ -0,0 +1,165 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+
+	libtime "github.com/bborbe/time"
+	"github.com/bborbe/time/mocks"
+)
+
+var _ = Describe("Location", func() {
+	var ctx context.Context
+	var berlin *time.Location
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		berlin, err = time.LoadLocation("Europe/Berlin")
+		Expect(err).To(BeNil())
+	})
+	DescribeTable("ParseLocation",
+		func(input any, expected string, expectError bool) {
+			result, err := libtime.ParseLocation(ctx, input)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(result.String()).To(Equal(expected))
+		},
+		Entry("name", "Europe/Berlin", "Europe/Berlin", false),
+		Entry("windows id", "W. Europe Standard Time", "Europe/Berlin", false),
+		Entry("utc", "UTC", "UTC", false),
+		Entry("std location", time.UTC, "UTC", false),
+		Entry("location", libtime.NewLocation(time.UTC), "UTC", false),
+		Entry("location ptr", libtime.NewLocation(time.UTC).Ptr(), "UTC", false),
+		Entry("nil std location", (*time.Location)(nil), "", true),
+		Entry("nil location ptr", (*libtime.Location)(nil), "", true),
+		Entry("unknown", "Mars/Olympus", "", true),
+	)
+	It("returns the std location", func() {
+		location := libtime.NewLocation(berlin)
+		Expect(location.Location()).To(BeIdenticalTo(berlin))
+		Expect(location.IsZero()).To(BeFalse())
+		Expect(location.String()).To(Equal("Europe/Berlin"))
+	})
+	It("behaves like UTC if zero", func() {
+		var location libtime.Location
+		Expect(location.IsZero()).To(BeTrue())
+		Expect(location.Location()).To(Equal(time.UTC))
+		Expect(location.String()).To(Equal(""))
+	})
+	It("validates", func() {
+		Expect(libtime.NewLocation(berlin).Validate(ctx)).To(BeNil())
+		Expect(libtime.Location{}.Validate(ctx)).NotTo(BeNil())
+	})
+	It("converts into zone-aware APIs", func() {
+		location := libtime.NewLocation(berlin)
+		dateTime := ParseDateTime("2024-07-01T10:00:00Z").In(location)
+		Expect(dateTime.Format(time.RFC3339)).To(Equal("2024-07-01T12:00:00+02:00"))
+		timeOfDay := ParseTimeOfDay("08:00").WithLocation(location)
+		Expect(timeOfDay.Location).To(BeIdenticalTo(berlin))
+		Expect(libtime.OffsetAt(location.Location(), dateTime)).To(Equal(2 * libtime.Hour))
+		parsed, err := libtime.ParseLocation(ctx, location)
+		Expect(err).To(BeNil())
+		Expect(parsed).To(BeIdenticalTo(berlin))
+	})
+	It("accepts any HasLocation", func() {
+		hasLocation := &mocks.HasLocation{}
+		hasLocation.LocationReturns(berlin)
+		result, err := libtime.ParseLocation(ctx, hasLocation)
+		Expect(err).To(BeNil())
+		Expect(result).To(BeIdenticalTo(berlin))
+		Expect(hasLocation.LocationCallCount()).To(Equal(1))
+	})
+	Context("marshaling", func() {
+		type TestStruct struct {
+			Location    libtime.Location  `json:"location"    yaml:"location"`
+			LocationPtr *libtime.Location `json:"locationPtr" yaml:"locationPtr"`
+		}
+		It("marshals json", func() {
+			bytes, err := json.Marshal(TestStruct{
+				Location:    libtime.NewLocation(berlin),
+				LocationPtr: libtime.NewLocation(time.UTC).Ptr(),
+			})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal(`{"location":"Europe/Berlin","locationPtr":"UTC"}`))
+			var result TestStruct
+			Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result.Location.String()).To(Equal("Europe/Berlin"))
+			Expect(result.LocationPtr.String()).To(Equal("UTC"))
+		})
+		It("marshals zero json as null", func() {
+			bytes, err := json.Marshal(TestStruct{})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal(`{"location":null,"locationPtr":null}`))
+			result := TestStruct{Location: libtime.NewLocation(berlin)}
+			Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result.Location.IsZero()).To(BeTrue())
+		})
+		It("returns an error for unknown json locations", func() {
+			var result TestStruct
+			Expect(json.Unmarshal([]byte(`{"location":"Mars/Olympus"}`), &result)).NotTo(Succeed())
+		})
+		It("marshals yaml", func() {
+			bytes, err := yaml.Marshal(TestStruct{Location: libtime.NewLocation(berlin)})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal("location: Europe/Berlin\nlocationPtr: null\n"))
+			var result TestStruct
+			Expect(yaml.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result.Location.String()).To(Equal("Europe/Berlin"))
+			Expect(result.LocationPtr).To(BeNil())
+		})
+		It("unmarshals yaml aliases", func() {
+			var result TestStruct
+			Expect(yaml.Unmarshal([]byte("location: Europe/Kiev\n"), &result)).To(Succeed())
+			Expect(result.Location.String()).To(Equal("Europe/Kiev"))
+			Expect(yaml.Unmarshal([]byte("location: Mars/Olympus\n"), &result)).NotTo(Succeed())
+		})
+		It("marshals text", func() {
+			bytes, err := libtime.NewLocation(berlin).MarshalText()
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal("Europe/Berlin"))
+			var result libtime.Location
+			Expect(result.UnmarshalText([]byte("UTC+02:00"))).To(Succeed())
+			Expect(result.String()).To(Equal("UTC+02:00"))
+		})
+	})
+	Context("sql", func() {
+		It("stores the name", func() {
+			value, err := libtime.NewLocation(berlin).Value()
+			Expect(err).To(BeNil())
+			Expect(value).To(Equal("Europe/Berlin"))
+			value, err = libtime.Location{}.Value()
+			Expect(err).To(BeNil())
+			Expect(value).To(BeNil())
+		})
+		DescribeTable("Scan",
+			func(src any, expected string, expectError bool) {
+				location := libtime.NewLocation(berlin)
+				err := location.Scan(src)
+				if expectError {
+					Expect(err).NotTo(BeNil())
+					return
+				}
+				Expect(err).To(BeNil())
+				Expect(location.String()).To(Equal(expected))
+			},
+			Entry("string", "America/New_York", "America/New_York", false),
+			Entry("bytes", []byte("UTC"), "UTC", false),
+			Entry("null", nil, "", false),
+			Entry("unknown", "Mars/Olympus", "", true),
+			Entry("int", 42, "", true),
+		)
+	})
+})
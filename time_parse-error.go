@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	stderrors "errors"
+	"fmt"
+	"strings"
+	stdtime "time"
+
+	"github.com/bborbe/validation"
+)
+
+// ParseError describes why an input could not be parsed. Parse functions return it wrapped,
+// use errors.As to get it. Every ParseError is invalid input, so errors.Is reports
+// validation.Error for it, and Err for the underlying error.
+type ParseError struct {
+	// Input is the value that could not be parsed.
+	Input string
+	// Position is the byte offset of the first unexpected character or -1 if unknown.
+	Position int
+	// Layouts contains the layouts tried in order, if any.
+	Layouts []string
+	// Reason explains what is wrong with the input at Position.
+	Reason string
+	// Err is the underlying error, like the *time.ParseError of the layout that got furthest.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "parse '%s' failed", e.Input)
+	if e.Position >= 0 {
+		fmt.Fprintf(&builder, " at position %d", e.Position)
+	}
+	if e.Reason != "" {
+		builder.WriteString(": ")
+		builder.WriteString(e.Reason)
+	}
+	if len(e.Layouts) > 0 {
+		builder.WriteString(" (layouts '")
+		builder.WriteString(strings.Join(e.Layouts, "', '"))
+		builder.WriteString("')")
+	}
+	return builder.String()
+}
+
+// Unwrap returns the sentinel validation.Error and Err, if set.
+func (e *ParseError) Unwrap() []error {
+	if e.Err == nil {
+		return []error{validation.Error}
+	}
+	return []error{validation.Error, e.Err}
+}
+
+// newParseError returns a ParseError for input with reason at position.
+func newParseError(input string, position int, reason string, args ...interface{}) *ParseError {
+	return &ParseError{
+		Input:    input,
+		Position: position,
+		Reason:   fmt.Sprintf(reason, args...),
+	}
+}
+
+// parseLayouts parses input with the first matching layout. Inputs without offset are parsed
+// in location, or UTC like time.Parse if location is nil. On failure it returns a ParseError
+// with position and reason of the layout that got furthest.
+func parseLayouts(
+	input string,
+	layouts []string,
+	location *stdtime.Location,
+) (stdtime.Time, error) {
+	result := &ParseError{Input: input, Position: -1, Layouts: layouts}
+	for _, layout := range layouts {
+		t, err := parseLayout(layout, input, location)
+		if err == nil {
+			return t, nil
+		}
+		position := -1
+		var parseError *stdtime.ParseError
+		if stderrors.As(err, &parseError) {
+			position = len(parseError.Value) - len(parseError.ValueElem)
+		}
+		if result.Err == nil || position > result.Position {
+			result.Position = position
+			result.Reason = layoutParseReason(err, parseError)
+			result.Err = err
+		}
+	}
+	return stdtime.Time{}, result
+}
+
+func parseLayout(layout string, input string, location *stdtime.Location) (stdtime.Time, error) {
+	if location == nil {
+		return stdtime.Parse(layout, input)
+	}
+	return stdtime.ParseInLocation(layout, input, location)
+}
+
+// layoutParseReason describes a time.ParseError without repeating the input.
+func layoutParseReason(err error, parseError *stdtime.ParseError) string {
+	switch {
+	case parseError == nil:
+		return err.Error()
+	case parseError.Message != "":
+		return strings.TrimPrefix(parseError.Message, ": ")
+	case parseError.ValueElem == "":
+		return fmt.Sprintf("missing %s", parseError.LayoutElem)
+	case parseError.LayoutElem == "":
+		return fmt.Sprintf("unexpected '%s'", parseError.ValueElem)
+	default:
+		return fmt.Sprintf("expected %s at '%s'", parseError.LayoutElem, parseError.ValueElem)
+	}
+}
@@ -0,0 +1,341 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"strings"
+	stdtime "time"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/parse"
+)
+
+// strictDurationUnit is a unit ParseDurationStrict accepts. Units must appear in order of rank.
+type strictDurationUnit struct {
+	name string
+	key  string
+	rank int
+}
+
+// strictCompactDurationUnits lists "ms" before "m" so the longest unit matches first.
+var strictCompactDurationUnits = []strictDurationUnit{
+	{name: "w", key: "w", rank: 0},
+	{name: "d", key: "d", rank: 1},
+	{name: "h", key: "h", rank: 2},
+	{name: "ms", key: "ms", rank: 5},
+	{name: "m", key: "m", rank: 3},
+	{name: "s", key: "s", rank: 4},
+	{name: "us", key: "us", rank: 6},
+	{name: "µs", key: "us", rank: 6},
+	{name: "μs", key: "us", rank: 6},
+	{name: "ns", key: "ns", rank: 7},
+}
+
+var strictISO8601DateUnits = []strictDurationUnit{
+	{name: "W", key: "w", rank: 0},
+	{name: "D", key: "d", rank: 1},
+}
+
+var strictISO8601TimeUnits = []strictDurationUnit{
+	{name: "H", key: "h", rank: 2},
+	{name: "M", key: "m", rank: 3},
+	{name: "S", key: "s", rank: 4},
+}
+
+// strictTimeOfDayLayouts are the layouts ParseTimeOfDayStrict accepts.
+var strictTimeOfDayLayouts = []string{
+	"15:04:05.999999999Z07:00",
+	"15:04:05.999999999",
+	"15:04Z07:00",
+	"15:04",
+}
+
+// ParseDurationStrict parses a duration like ParseDuration but rejects surprising inputs:
+// empty values, numbers without unit except "0", units in the wrong case, order or repeated,
+// fractions without leading digit and whitespace. It accepts the formats of DurationFormat.
+// Failures return a wrapped *ParseError.
+func ParseDurationStrict(ctx context.Context, value interface{}) (*Duration, error) {
+	switch value.(type) {
+	case Duration, *Duration, stdtime.Duration, *stdtime.Duration:
+		return ParseDuration(ctx, value)
+	}
+	str, err := parse.ParseString(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse value failed")
+	}
+	result, parseError := parseDurationStrict(ctx, str)
+	if parseError != nil {
+		return nil, errors.Wrapf(ctx, parseError, "parse duration failed")
+	}
+	return result.Ptr(), nil
+}
+
+// ParseTimeStrict parses a time like ParseTime but only accepts RFC 3339 with offset
+// and "NOW" optionally followed by a signed strict duration like "NOW-14d".
+// NOW uses the clock of the Parser of the context, see ContextWithParser.
+// Failures return a wrapped *ParseError.
+func ParseTimeStrict(ctx context.Context, value interface{}) (*stdtime.Time, error) {
+	str, err := parse.ParseString(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse value failed")
+	}
+	const nowConst = "NOW"
+	if strings.HasPrefix(str, nowConst) {
+		rest := str[len(nowConst):]
+		now := parserNow(ctx)
+		if rest == "" {
+			return &now, nil
+		}
+		if rest[0] != '+' && rest[0] != '-' {
+			return nil, errors.Wrapf(
+				ctx,
+				newParseError(str, len(nowConst), "expected '+' or '-' after %s", nowConst),
+				"parse time failed",
+			)
+		}
+		duration, parseError := parseDurationStrict(ctx, rest)
+		if parseError != nil {
+			parseError.Input = str
+			parseError.Position += len(nowConst)
+			return nil, errors.Wrapf(ctx, parseError, "parse time failed")
+		}
+		now = now.Add(duration.Duration())
+		return &now, nil
+	}
+	t, err := parseLayouts(str, []string{stdtime.RFC3339Nano}, nil)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse time failed")
+	}
+	return &t, nil
+}
+
+// ParseTimeOfDayStrict parses a time of day like ParseTimeOfDay but only accepts clocks
+// like "15:04", "15:04:05.5" or "15:04+02:00", optionally followed by a single space
+// and a location, and "NOW". Dates and trailing text are rejected.
+// NOW uses the clock of the Parser of the context, see ContextWithParser.
+// Failures return a wrapped *ParseError.
+func ParseTimeOfDayStrict(ctx context.Context, value interface{}) (*TimeOfDay, error) {
+	str, err := parse.ParseString(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse value failed")
+	}
+	if str == "NOW" {
+		return TimeOfDayFromTime(parserNow(ctx)).Ptr(), nil
+	}
+	clock, locationName, hasLocation := strings.Cut(str, " ")
+	t, err := parseLayouts(clock, strictTimeOfDayLayouts, nil)
+	if err != nil {
+		var parseError *ParseError
+		if errors.As(err, &parseError) {
+			parseError.Input = str
+		}
+		return nil, errors.Wrapf(ctx, err, "parse timeOfDay failed")
+	}
+	result := TimeOfDayFromTime(t.In(stdtime.UTC))
+	if hasLocation {
+		if locationName == "" || locationName != strings.TrimSpace(locationName) {
+			return nil, errors.Wrapf(
+				ctx,
+				newParseError(str, len(clock)+1, "expected a single space before the location"),
+				"parse timeOfDay failed",
+			)
+		}
+		location, err := LoadLocation(ctx, locationName)
+		if err != nil {
+			parseError := newParseError(str, len(clock)+1, "unknown location '%s'", locationName)
+			parseError.Err = err
+			return nil, errors.Wrapf(ctx, parseError, "parse timeOfDay failed")
+		}
+		result.Location = location
+	}
+	return &result, nil
+}
+
+// parseDurationStrict parses str with the rules of ParseDurationStrict.
+func parseDurationStrict(ctx context.Context, str string) (Duration, *ParseError) {
+	if str == "" {
+		return 0, newParseError(str, 0, "empty duration")
+	}
+	if str == "0" {
+		return 0, nil
+	}
+	position := 0
+	isNegative := false
+	if str[0] == '+' || str[0] == '-' {
+		isNegative = str[0] == '-'
+		position++
+	}
+	var magnitude uint64
+	var parseError *ParseError
+	switch {
+	case strings.HasPrefix(str[position:], "P"):
+		magnitude, parseError = parseISO8601DurationStrict(ctx, str, position+1)
+	case strings.HasPrefix(str[position:], "p"):
+		parseError = newParseError(str, position, "'p' must be written as 'P'")
+	default:
+		var count int
+		magnitude, position, count, parseError = scanDurationStrict(
+			ctx, str, position, strictCompactDurationUnits,
+		)
+		if parseError == nil && position < len(str) {
+			parseError = newParseError(str, position, "unexpected '%s'", str[position:])
+		}
+		if parseError == nil && count == 0 {
+			parseError = newParseError(str, position, "expected a number")
+		}
+	}
+	if parseError != nil {
+		return 0, parseError
+	}
+	result, err := durationFromMagnitude(ctx, magnitude, isNegative)
+	if err != nil {
+		parseError = newParseError(str, 0, "duration overflows")
+		parseError.Err = err
+		return 0, parseError
+	}
+	return *result, nil
+}
+
+// parseISO8601DurationStrict parses the ISO 8601 duration of str after the "P" at position.
+func parseISO8601DurationStrict(
+	ctx context.Context,
+	str string,
+	position int,
+) (uint64, *ParseError) {
+	magnitude, position, count, parseError := scanDurationStrict(
+		ctx, str, position, strictISO8601DateUnits,
+	)
+	if parseError != nil {
+		return 0, parseError
+	}
+	if position < len(str) && str[position] == 'T' {
+		position++
+		var timeMagnitude uint64
+		var timeCount int
+		timeMagnitude, position, timeCount, parseError = scanDurationStrict(
+			ctx, str, position, strictISO8601TimeUnits,
+		)
+		if parseError != nil {
+			return 0, parseError
+		}
+		if timeCount == 0 {
+			return 0, newParseError(str, position, "expected hours, minutes or seconds after 'T'")
+		}
+		var err error
+		if magnitude, err = addDurationMagnitude(ctx, magnitude, timeMagnitude); err != nil {
+			parseError = newParseError(str, 0, "duration overflows")
+			parseError.Err = err
+			return 0, parseError
+		}
+		count += timeCount
+	}
+	if position < len(str) {
+		return 0, newParseError(str, position, "unexpected '%s'", str[position:])
+	}
+	if count == 0 {
+		return 0, newParseError(str, position, "expected a number")
+	}
+	return magnitude, nil
+}
+
+// scanDurationStrict reads components like "1.5h" from position until it reaches the end
+// of str or a character that starts no number. It returns the magnitude, the position after
+// the last component and the number of components.
+func scanDurationStrict(
+	ctx context.Context,
+	str string,
+	position int,
+	units []strictDurationUnit,
+) (uint64, int, int, *ParseError) {
+	var magnitude uint64
+	var count int
+	lastRank := -1
+	for position < len(str) && isDigit(str[position]) {
+		start := position
+		for position < len(str) && isDigit(str[position]) {
+			position++
+		}
+		if position < len(str) && str[position] == '.' {
+			position++
+			if position == len(str) || !isDigit(str[position]) {
+				return 0, position, count, newParseError(str, position, "expected digits after '.'")
+			}
+			for position < len(str) && isDigit(str[position]) {
+				position++
+			}
+		}
+		number := str[start:position]
+		unit, ok := matchStrictDurationUnit(str[position:], units)
+		if !ok {
+			return 0, position, count, unknownStrictDurationUnit(str, position, number, units)
+		}
+		if unit.rank <= lastRank {
+			return 0, position, count, newParseError(
+				str,
+				position,
+				"unit '%s' is out of order or repeated",
+				unit.name,
+			)
+		}
+		duration, err := parseAsDuration(ctx, number, unit.key)
+		if err == nil {
+			magnitude, err = addDurationMagnitude(ctx, magnitude, duration)
+		}
+		if err != nil {
+			parseError := newParseError(str, start, "duration overflows")
+			parseError.Err = err
+			return 0, position, count, parseError
+		}
+		lastRank = unit.rank
+		position += len(unit.name)
+		count++
+	}
+	if position < len(str) && str[position] == '.' {
+		return 0, position, count, newParseError(str, position, "expected a digit before '.'")
+	}
+	return magnitude, position, count, nil
+}
+
+func matchStrictDurationUnit(rest string, units []strictDurationUnit) (strictDurationUnit, bool) {
+	for _, unit := range units {
+		if strings.HasPrefix(rest, unit.name) {
+			return unit, true
+		}
+	}
+	return strictDurationUnit{}, false
+}
+
+// unknownStrictDurationUnit explains why no unit follows number at position.
+func unknownStrictDurationUnit(
+	str string,
+	position int,
+	number string,
+	units []strictDurationUnit,
+) *ParseError {
+	rest := str[position:]
+	if rest == "" {
+		return newParseError(str, position, "missing unit after '%s'", number)
+	}
+	for _, unit := range units {
+		if len(rest) >= len(unit.name) && strings.EqualFold(rest[:len(unit.name)], unit.name) {
+			return newParseError(
+				str,
+				position,
+				"unit '%s' must be written as '%s'",
+				rest[:len(unit.name)],
+				unit.name,
+			)
+		}
+	}
+	if strings.ContainsRune("YyMm", rune(rest[0])) && units[0].name == "W" {
+		return newParseError(str, position, "years and months have no fixed duration")
+	}
+	return newParseError(str, position, "unknown unit at '%s'", rest)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/validation"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("Strict parsing", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	asParseError := func(err error) *libtime.ParseError {
+		var parseError *libtime.ParseError
+		Expect(stderrors.As(err, &parseError)).To(BeTrue())
+		return parseError
+	}
+	DescribeTable("ParseDurationStrict",
+		func(input string, expected libtime.Duration) {
+			result, err := libtime.ParseDurationStrict(ctx, input)
+			Expect(err).To(BeNil())
+			Expect(*result).To(Equal(expected))
+		},
+		Entry("zero", "0", libtime.Duration(0)),
+		Entry("compact", "1w2d3h", libtime.Week+2*libtime.Day+3*libtime.Hour),
+		Entry("go", "219h4m5.5s", 219*libtime.Hour+4*libtime.Minute+5500*libtime.Millisecond),
+		Entry("sub-second", "1s500ms3µs", libtime.Second+500*libtime.Millisecond+3*libtime.Microsecond),
+		Entry("negative", "-1h30m", -90*libtime.Minute),
+		Entry("positive", "+1h", libtime.Hour),
+		Entry("iso8601", "P1DT2H", libtime.Day+2*libtime.Hour),
+		Entry("iso8601 negative", "-PT1.5S", -1500*libtime.Millisecond),
+		Entry("iso8601 weeks", "P2W", 2*libtime.Week),
+	)
+	DescribeTable("ParseDurationStrict errors",
+		func(input string, expectedPosition int, expectedReason string) {
+			result, err := libtime.ParseDurationStrict(ctx, input)
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(BeNil())
+			parseError := asParseError(err)
+			Expect(parseError.Input).To(Equal(input))
+			Expect(parseError.Position).To(Equal(expectedPosition))
+			Expect(parseError.Reason).To(Equal(expectedReason))
+		},
+		Entry("empty", "", 0, "empty duration"),
+		Entry("missing unit", "15", 2, "missing unit after '15'"),
+		Entry("missing last unit", "1h30", 4, "missing unit after '30'"),
+		Entry("uppercase", "1H", 1, "unit 'H' must be written as 'h'"),
+		Entry("uppercase ms", "1MS", 1, "unit 'MS' must be written as 'ms'"),
+		Entry("wrong order", "1m1h", 3, "unit 'h' is out of order or repeated"),
+		Entry("repeated", "1h1h", 3, "unit 'h' is out of order or repeated"),
+		Entry("double dot", "1.5.3s", 3, "unknown unit at '.3s'"),
+		Entry("leading dot", ".5s", 0, "expected a digit before '.'"),
+		Entry("trailing dot", "5.s", 2, "expected digits after '.'"),
+		Entry("trailing garbage", "1h foo", 2, "unexpected ' foo'"),
+		Entry("leading space", " 1h", 0, "unexpected ' 1h'"),
+		Entry("unknown unit", "1y", 1, "unknown unit at 'y'"),
+		Entry("sign only", "-", 1, "expected a number"),
+		Entry("overflow", "2562048h", 0, "duration overflows"),
+		Entry("iso8601 lowercase p", "p1d", 0, "'p' must be written as 'P'"),
+		Entry("iso8601 lowercase unit", "PT1h", 3, "unit 'h' must be written as 'H'"),
+		Entry("iso8601 months", "P1M", 2, "years and months have no fixed duration"),
+		Entry("iso8601 empty", "P", 1, "expected a number"),
+		Entry("iso8601 empty time", "P1DT", 4, "expected hours, minutes or seconds after 'T'"),
+		Entry("iso8601 comma", "PT1,5S", 3, "unknown unit at ',5S'"),
+	)
+	It("accepts typed durations", func() {
+		result, err := libtime.ParseDurationStrict(ctx, libtime.Hour)
+		Expect(err).To(BeNil())
+		Expect(*result).To(Equal(libtime.Hour))
+	})
+	Context("ParseTimeStrict", func() {
+		BeforeEach(func() {
+			libtime.Now = func() time.Time {
+				return time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+			}
+			DeferCleanup(func() {
+				libtime.Now = time.Now
+			})
+		})
+		DescribeTable("valid",
+			func(input string, expected string) {
+				result, err := libtime.ParseTimeStrict(ctx, input)
+				Expect(err).To(BeNil())
+				Expect(result.UTC().Format(time.RFC3339Nano)).To(Equal(expected))
+			},
+			Entry("rfc3339", "2024-03-05T10:00:00Z", "2024-03-05T10:00:00Z"),
+			Entry("rfc3339 nano", "2024-03-05T10:00:00.5+02:00", "2024-03-05T08:00:00.5Z"),
+			Entry("now", "NOW", "2024-03-05T12:00:00Z"),
+			Entry("now minus", "NOW-1d", "2024-03-04T12:00:00Z"),
+		)
+		DescribeTable("invalid",
+			func(input string, expectedPosition int, expectedReason string) {
+				result, err := libtime.ParseTimeStrict(ctx, input)
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+				parseError := asParseError(err)
+				Expect(parseError.Input).To(Equal(input))
+				Expect(parseError.Position).To(Equal(expectedPosition))
+				Expect(parseError.Reason).To(Equal(expectedReason))
+			},
+			Entry("date only", "2024-03-05", 10, "missing T"),
+			Entry("without offset", "2024-03-05T10:00:00", 19, "missing Z07:00"),
+			Entry("trailing garbage", "2024-03-05T10:00:00Zfoo", 20, `extra text: "foo"`),
+			Entry("now without sign", "NOW1d", 3, "expected '+' or '-' after NOW"),
+			Entry("now uppercase unit", "NOW-1D", 5, "unit 'D' must be written as 'd'"),
+		)
+	})
+	It("resolves NOW with the clock of the parser", func() {
+		ctx = libtime.ContextWithParser(ctx, libtime.NewParser(libtime.ParserOptions{
+			CurrentDateTimeGetter: libtime.CurrentDateTimeGetterFunc(func() libtime.DateTime {
+				return libtime.DateTime(time.Date(2024, time.March, 5, 12, 30, 0, 0, time.UTC))
+			}),
+		}))
+		result, err := libtime.ParseTimeStrict(ctx, "NOW-1h")
+		Expect(err).To(BeNil())
+		Expect(result.UTC().Format(time.RFC3339)).To(Equal("2024-03-05T11:30:00Z"))
+		timeOfDay, err := libtime.ParseTimeOfDayStrict(ctx, "NOW")
+		Expect(err).To(BeNil())
+		Expect(timeOfDay.String()).To(Equal("12:30:00Z"))
+	})
+	DescribeTable("ParseTimeOfDayStrict",
+		func(input string, expected string, expectedPosition int) {
+			result, err := libtime.ParseTimeOfDayStrict(ctx, input)
+			if expectedPosition >= 0 {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+				parseError := asParseError(err)
+				Expect(parseError.Input).To(Equal(input))
+				Expect(parseError.Position).To(Equal(expectedPosition))
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(result.String()).To(Equal(expected))
+		},
+		Entry("minutes", "08:30", "08:30:00Z", -1),
+		Entry("seconds", "08:30:15.5", "08:30:15.5Z", -1),
+		Entry("offset", "08:30+02:00", "06:30:00Z", -1),
+		Entry("location", "08:30 Europe/Berlin", "08:30:00+01:00", -1),
+		Entry("date time", "2024-03-05T08:30:00Z", "", 2),
+		Entry("trailing garbage", "08:30foo", "", 5),
+		Entry("unknown location", "08:30 Mars/Olympus", "", 6),
+		Entry("double space", "08:30  Europe/Berlin", "", 6),
+		Entry("now prefix", "NOWHERE", "", 0),
+	)
+})
+
+var _ = Describe("ParseError", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	It("describes the failure", func() {
+		parseError := &libtime.ParseError{
+			Input:    "1h foo",
+			Position: 2,
+			Layouts:  []string{"a", "b"},
+			Reason:   "unexpected ' foo'",
+		}
+		Expect(parseError.Error()).To(Equal(
+			"parse '1h foo' failed at position 2: unexpected ' foo' (layouts 'a', 'b')",
+		))
+		parseError.Position = -1
+		parseError.Layouts = nil
+		Expect(parseError.Error()).To(Equal("parse '1h foo' failed: unexpected ' foo'"))
+	})
+	It("is returned by ParseTime with all layouts", func() {
+		_, err := libtime.ParseTime(ctx, "2024-03-05X10:00")
+		Expect(err).NotTo(BeNil())
+		var parseError *libtime.ParseError
+		Expect(stderrors.As(err, &parseError)).To(BeTrue())
+		Expect(parseError.Layouts).To(HaveLen(5))
+		Expect(parseError.Position).To(Equal(10))
+		var timeParseError *time.ParseError
+		Expect(stderrors.As(err, &timeParseError)).To(BeTrue())
+	})
+	It("is returned by ParseTimeOfDay and ParseDuration", func() {
+		var parseError *libtime.ParseError
+		_, err := libtime.ParseTimeOfDay(ctx, "25:00")
+		Expect(stderrors.As(err, &parseError)).To(BeTrue())
+		Expect(parseError.Input).To(Equal("25:00"))
+		_, err = libtime.ParseDuration(ctx, "hello")
+		Expect(stderrors.As(err, &parseError)).To(BeTrue())
+		Expect(parseError.Input).To(Equal("hello"))
+		Expect(parseError.Reason).To(Equal("unknown duration format"))
+	})
+	It("is a validation error", func() {
+		_, err := libtime.ParseDurationStrict(ctx, "1H")
+		Expect(stderrors.Is(err, validation.Error)).To(BeTrue())
+	})
+})
@@ -6,11 +6,7 @@ package time
 
 import (
 	"context"
-	"strings"
 	stdtime "time"
-
-	"github.com/bborbe/errors"
-	"github.com/bborbe/parse"
 )
 
 func ParseTimeDefault(
@@ -25,36 +21,27 @@ func ParseTimeDefault(
 	return *result
 }
 
+// ParseTime parses RFC 3339 times, dates and "NOW" optionally followed by a duration like "NOW-14d"
+// with the Parser of the context or the default Parser, see ContextWithParser and SetDefaultParser.
 func ParseTime(ctx context.Context, value interface{}) (*stdtime.Time, error) {
-	str, err := parse.ParseString(ctx, value)
-	if err != nil {
-		return nil, errors.Wrapf(ctx, err, "parse value failed")
-	}
-	const nowConst = "NOW"
-	if strings.HasPrefix(str, nowConst) {
-		now := Now()
-		if len(str) > len(nowConst) {
-			durationString := str[len(nowConst):]
-			duration, err := ParseDuration(ctx, durationString)
-			if err != nil {
-				return nil, errors.Wrapf(ctx, err, "parse duration '%s' failed", durationString)
-			}
-			now = now.Add(duration.Duration())
-		}
-		return &now, nil
-	}
-	var t stdtime.Time
-	for _, layout := range []string{
-		stdtime.RFC3339Nano,
-		stdtime.RFC3339,
-		"2006-01-02T15:04Z07:00",
-		stdtime.DateTime,
-		stdtime.DateOnly,
-	} {
-		t, err = stdtime.Parse(layout, str)
-		if err == nil {
-			return &t, nil
-		}
-	}
-	return nil, errors.Wrapf(ctx, err, "parse time failed")
+	return ParserFromContext(ctx).ParseTime(ctx, value)
+}
+
+// ParseTimeInLocation parses like ParseTime, but inputs without offset like "2006-01-02 15:04:05"
+// are interpreted as wall clock time in location instead of UTC. A nil location means UTC.
+func ParseTimeInLocation(
+	ctx context.Context,
+	value interface{},
+	location *stdtime.Location,
+) (*stdtime.Time, error) {
+	return ParserFromContext(ctx).WithLocation(location).ParseTime(ctx, value)
+}
+
+// parseTimeLayouts are the layouts ParseTime tries in order.
+var parseTimeLayouts = []string{
+	stdtime.RFC3339Nano,
+	stdtime.RFC3339,
+	"2006-01-02T15:04Z07:00",
+	stdtime.DateTime,
+	stdtime.DateOnly,
 }
@@ -73,3 +73,44 @@ var _ = Describe("ParseTime", func() {
 		})
 	})
 })
+
+var _ = Describe("ParseTimeInLocation", func() {
+	var ctx context.Context
+	var berlin *stdtime.Location
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		berlin, err = libtime.LoadLocation(ctx, "Europe/Berlin")
+		Expect(err).To(BeNil())
+	})
+	DescribeTable("parses",
+		func(input string, expected string) {
+			result, err := libtime.ParseTimeInLocation(ctx, input, berlin)
+			Expect(err).To(BeNil())
+			Expect(result.UTC().Format(stdtime.RFC3339)).To(Equal(expected))
+		},
+		Entry("date time winter", "2024-03-01 10:00:00", "2024-03-01T09:00:00Z"),
+		Entry("date time summer", "2024-07-01 10:00:00", "2024-07-01T08:00:00Z"),
+		Entry("date", "2024-03-01", "2024-02-29T23:00:00Z"),
+		Entry("offset wins", "2024-03-01T10:00:00Z", "2024-03-01T10:00:00Z"),
+	)
+	It("returns the wall clock in the location", func() {
+		result, err := libtime.ParseTimeInLocation(ctx, "2024-03-01 10:00:00", berlin)
+		Expect(err).To(BeNil())
+		Expect(result.Location()).To(Equal(berlin))
+		Expect(result.Hour()).To(Equal(10))
+	})
+	It("uses UTC for a nil location", func() {
+		result, err := libtime.ParseTimeInLocation(ctx, "2024-03-01 10:00:00", nil)
+		Expect(err).To(BeNil())
+		Expect(*result).To(Equal(stdtime.Date(2024, stdtime.March, 1, 10, 0, 0, 0, stdtime.UTC)))
+	})
+	It("keeps the layouts of the parser in the context", func() {
+		ctx = libtime.ContextWithParser(ctx, libtime.NewParser(libtime.ParserOptions{
+			Layouts: libtime.Layouts{"02.01.2006 15:04"},
+		}))
+		result, err := libtime.ParseTimeInLocation(ctx, "01.03.2024 10:00", berlin)
+		Expect(err).To(BeNil())
+		Expect(result.UTC()).To(Equal(stdtime.Date(2024, stdtime.March, 1, 9, 0, 0, 0, stdtime.UTC)))
+	})
+})
@@ -0,0 +1,299 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"strings"
+	stdtime "time"
+)
+
+// ParserLocale is the phrase table for natural-language inputs of a Parser like "yesterday",
+// "today 09:00", "last friday" or "next month". All names are lower case.
+// A phrase is a day, a modifier followed by a weekday or unit, optionally followed
+// by a time of day like ParseTimeOfDay reads, which may be introduced by At.
+type ParserLocale struct {
+	// Days maps names to day offsets like "yesterday" to -1.
+	Days map[string]int
+	// Modifiers maps names to offsets like "last" to -1, "this" to 0 and "next" to 1.
+	Modifiers map[string]int
+	// Weekdays maps names to weekdays. Nil uses the English names of ParseWeekday.
+	Weekdays map[string]Weekday
+	// Units maps names to Day, Week, HumanizeMonth or HumanizeYear.
+	Units map[string]Duration
+	// At contains the words allowed between day and time of day like "at".
+	At []string
+}
+
+var ParserLocaleEnglish = ParserLocale{
+	Days: map[string]int{
+		"yesterday": -1,
+		"today":     0,
+		"tomorrow":  1,
+	},
+	Modifiers: map[string]int{
+		"last": -1,
+		"this": 0,
+		"next": 1,
+	},
+	Units: map[string]Duration{
+		"day":   Day,
+		"week":  Week,
+		"month": HumanizeMonth,
+		"year":  HumanizeYear,
+	},
+	At: []string{"at"},
+}
+
+var ParserLocaleGerman = ParserLocale{
+	Days: map[string]int{
+		"vorgestern": -2,
+		"gestern":    -1,
+		"heute":      0,
+		"morgen":     1,
+		"übermorgen": 2,
+	},
+	Modifiers: map[string]int{
+		"letzte":   -1,
+		"letzten":  -1,
+		"letzter":  -1,
+		"letztes":  -1,
+		"diese":    0,
+		"diesen":   0,
+		"dieser":   0,
+		"dieses":   0,
+		"nächste":  1,
+		"nächsten": 1,
+		"nächster": 1,
+		"nächstes": 1,
+	},
+	Weekdays: map[string]Weekday{
+		"montag":     Monday,
+		"mo":         Monday,
+		"dienstag":   Tuesday,
+		"di":         Tuesday,
+		"mittwoch":   Wednesday,
+		"mi":         Wednesday,
+		"donnerstag": Thursday,
+		"do":         Thursday,
+		"freitag":    Friday,
+		"fr":         Friday,
+		"samstag":    Saturday,
+		"sa":         Saturday,
+		"sonntag":    Sunday,
+		"so":         Sunday,
+	},
+	Units: map[string]Duration{
+		"tag":   Day,
+		"woche": Week,
+		"monat": HumanizeMonth,
+		"jahr":  HumanizeYear,
+	},
+	At: []string{"um"},
+}
+
+// ParserLocales contains all known locales by language code.
+// Add entries to make further languages available via ParserLocaleByName.
+var ParserLocales = map[string]ParserLocale{
+	"en": ParserLocaleEnglish,
+	"de": ParserLocaleGerman,
+}
+
+// ParserLocaleByName returns the locale registered for the given language code
+// and falls back to English for unknown codes.
+func ParserLocaleByName(name string) ParserLocale {
+	if locale, ok := ParserLocales[strings.ToLower(name)]; ok {
+		return locale
+	}
+	return ParserLocaleEnglish
+}
+
+func (l ParserLocale) weekday(name string) (Weekday, bool) {
+	if l.Weekdays == nil {
+		weekday, ok := weekdayNames[name]
+		return weekday, ok
+	}
+	weekday, ok := l.Weekdays[name]
+	return weekday, ok
+}
+
+// startsPhrase reports whether name is the first word of a phrase, a day or a modifier.
+// Bare weekdays are no phrase, so layouts like time.ANSIC ("Mon Jan _2 ...") still parse.
+func (l ParserLocale) startsPhrase(name string) bool {
+	if _, ok := l.Days[name]; ok {
+		return true
+	}
+	_, ok := l.Modifiers[name]
+	return ok
+}
+
+// parserWord is a word of the input with its byte offset.
+type parserWord struct {
+	value    string
+	lower    string
+	position int
+}
+
+func splitParserWords(str string) []parserWord {
+	var result []parserWord
+	position := 0
+	for _, field := range strings.Fields(str) {
+		position += strings.Index(str[position:], field)
+		result = append(result, parserWord{
+			value:    field,
+			lower:    strings.ToLower(field),
+			position: position,
+		})
+		position += len(field)
+	}
+	return result
+}
+
+// parseNatural parses str as phrase of the locale. It returns false if str starts with no
+// word of the locale, so the layouts are tried. Invalid phrases return a *ParseError.
+func (p *parser) parseNatural(ctx context.Context, str string) (*stdtime.Time, bool, error) {
+	locale := p.options.Locale
+	if locale == nil {
+		return nil, false, nil
+	}
+	words := splitParserWords(str)
+	if len(words) == 0 || !locale.startsPhrase(words[0].lower) {
+		return nil, false, nil
+	}
+	if !p.allowsKeyword(words[0].lower) {
+		return nil, true, newParseError(
+			str,
+			words[0].position,
+			"keyword '%s' is not allowed",
+			words[0].value,
+		)
+	}
+	now := p.options.CurrentDateTimeGetter.Now().Time()
+	if p.options.Location != nil {
+		now = now.In(p.options.Location)
+	}
+	day, rest, parseError := locale.resolveDay(str, words, now)
+	if parseError != nil {
+		return nil, true, parseError
+	}
+	if len(rest) > 0 && containsFold(locale.At, rest[0].lower) {
+		if len(rest) == 1 {
+			return nil, true, newParseError(
+				str,
+				len(str),
+				"expected a time of day after '%s'",
+				rest[0].value,
+			)
+		}
+		rest = rest[1:]
+	}
+	if len(rest) == 0 {
+		return &day, true, nil
+	}
+	clock := str[rest[0].position:]
+	timeOfDay, err := ParseTimeOfDay(ctx, clock)
+	if err != nil || strings.HasPrefix(clock, ParserKeywordNow) {
+		parseError = newParseError(str, rest[0].position, "expected a time of day at '%s'", clock)
+		parseError.Err = err
+		return nil, true, parseError
+	}
+	if !strings.ContainsAny(clock, " Z+-") {
+		timeOfDay.Location = day.Location()
+	}
+	result := timeOfDay.Time(day.Year(), day.Month(), day.Day())
+	return &result, true, nil
+}
+
+// resolveDay returns the beginning of the day the phrase in words refers to
+// and the words after it. The first word is a day or modifier, see startsPhrase.
+func (l ParserLocale) resolveDay(
+	str string,
+	words []parserWord,
+	now stdtime.Time,
+) (stdtime.Time, []parserWord, *ParseError) {
+	first := words[0]
+	if offset, ok := l.Days[first.lower]; ok {
+		return BeginningOfDay(now).AddDate(0, 0, offset), words[1:], nil
+	}
+	modifier := l.Modifiers[first.lower]
+	if len(words) == 1 {
+		return stdtime.Time{}, nil, newParseError(
+			str,
+			len(str),
+			"expected a weekday or unit after '%s'",
+			first.value,
+		)
+	}
+	second := words[1]
+	if weekday, ok := l.weekday(second.lower); ok {
+		return relativeWeekday(now, weekday, modifier), words[2:], nil
+	}
+	if unit, ok := l.Units[second.lower]; ok {
+		result, ok := relativeUnit(now, unit, modifier)
+		if !ok {
+			return stdtime.Time{}, nil, newParseError(
+				str,
+				second.position,
+				"unit '%s' has unsupported duration %s",
+				second.value,
+				unit,
+			)
+		}
+		return result, words[2:], nil
+	}
+	return stdtime.Time{}, nil, newParseError(
+		str,
+		second.position,
+		"unknown weekday or unit '%s'",
+		second.value,
+	)
+}
+
+// relativeWeekday returns the weekday before today for negative modifiers, after today
+// for positive ones and in the current week for zero. Larger modifiers skip weeks.
+func relativeWeekday(now stdtime.Time, weekday Weekday, modifier int) stdtime.Time {
+	today := BeginningOfDay(now)
+	diff := int(weekday) - int(today.Weekday())
+	switch {
+	case modifier < 0:
+		if diff >= 0 {
+			diff -= 7
+		}
+		diff += 7 * (modifier + 1)
+	case modifier > 0:
+		if diff <= 0 {
+			diff += 7
+		}
+		diff += 7 * (modifier - 1)
+	default:
+		return BeginningOfWeek(now).AddDate(0, 0, (int(weekday)+6)%7)
+	}
+	return today.AddDate(0, 0, diff)
+}
+
+// relativeUnit returns the beginning of the day, week, month or year modifier units from now.
+func relativeUnit(now stdtime.Time, unit Duration, modifier int) (stdtime.Time, bool) {
+	switch unit {
+	case Day:
+		return BeginningOfDay(now).AddDate(0, 0, modifier), true
+	case Week:
+		return BeginningOfWeek(now).AddDate(0, 0, 7*modifier), true
+	case HumanizeMonth:
+		return BeginningOfMonth(now).AddDate(0, modifier, 0), true
+	case HumanizeYear:
+		return BeginningOfYear(now).AddDate(modifier, 0, 0), true
+	default:
+		return stdtime.Time{}, false
+	}
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
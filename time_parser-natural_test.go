@@ -0,0 +1,172 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	stderrors "errors"
+	stdtime "time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("Parser natural language", func() {
+	var ctx context.Context
+	var now stdtime.Time
+	var options libtime.ParserOptions
+	BeforeEach(func() {
+		ctx = context.Background()
+		// Tuesday
+		now = stdtime.Date(2024, stdtime.March, 5, 12, 0, 0, 0, stdtime.UTC)
+		options = libtime.ParserOptions{
+			Locale: &libtime.ParserLocaleEnglish,
+			CurrentDateTimeGetter: libtime.CurrentDateTimeGetterFunc(func() libtime.DateTime {
+				return libtime.DateTime(now)
+			}),
+		}
+	})
+	asParseError := func(err error) *libtime.ParseError {
+		var parseError *libtime.ParseError
+		Expect(stderrors.As(err, &parseError)).To(BeTrue())
+		return parseError
+	}
+	DescribeTable("english",
+		func(input string, expected string) {
+			result, err := libtime.NewParser(options).ParseTime(ctx, input)
+			Expect(err).To(BeNil())
+			Expect(result.UTC().Format(stdtime.RFC3339)).To(Equal(expected))
+		},
+		Entry("yesterday", "yesterday", "2024-03-04T00:00:00Z"),
+		Entry("today", "today", "2024-03-05T00:00:00Z"),
+		Entry("tomorrow", "tomorrow", "2024-03-06T00:00:00Z"),
+		Entry("upper case", "Yesterday", "2024-03-04T00:00:00Z"),
+		Entry("today with time", "today 09:00", "2024-03-05T09:00:00Z"),
+		Entry("today at time", "today at 09:30:15", "2024-03-05T09:30:15Z"),
+		Entry("time with location", "tomorrow 09:00 Europe/Berlin", "2024-03-06T08:00:00Z"),
+		Entry("time with offset", "today 09:00+02:00", "2024-03-05T07:00:00Z"),
+		Entry("last friday", "last friday", "2024-03-01T00:00:00Z"),
+		Entry("next friday", "next friday", "2024-03-08T00:00:00Z"),
+		Entry("this friday", "this friday", "2024-03-08T00:00:00Z"),
+		Entry("this monday", "this monday", "2024-03-04T00:00:00Z"),
+		Entry("this sunday", "this sunday", "2024-03-10T00:00:00Z"),
+		Entry("last tuesday", "last tuesday", "2024-02-27T00:00:00Z"),
+		Entry("next tuesday", "next tuesday", "2024-03-12T00:00:00Z"),
+		Entry("abbreviation", "next fri 18:00", "2024-03-08T18:00:00Z"),
+		Entry("next day", "next day", "2024-03-06T00:00:00Z"),
+		Entry("last week", "last week", "2024-02-26T00:00:00Z"),
+		Entry("this week", "this week", "2024-03-04T00:00:00Z"),
+		Entry("next month", "next month", "2024-04-01T00:00:00Z"),
+		Entry("last month", "last month", "2024-02-01T00:00:00Z"),
+		Entry("next year", "next year", "2025-01-01T00:00:00Z"),
+		Entry("now still works", "NOW-1h", "2024-03-05T11:00:00Z"),
+		Entry("layouts still work", "2024-03-05T10:00:00Z", "2024-03-05T10:00:00Z"),
+	)
+	DescribeTable("errors",
+		func(input string, expectedPosition int, expectedReason string) {
+			result, err := libtime.NewParser(options).ParseTime(ctx, input)
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(BeNil())
+			parseError := asParseError(err)
+			Expect(parseError.Input).To(Equal(input))
+			Expect(parseError.Position).To(Equal(expectedPosition))
+			Expect(parseError.Reason).To(Equal(expectedReason))
+			Expect(parseError.Layouts).To(BeEmpty())
+		},
+		Entry("modifier only", "last", 4, "expected a weekday or unit after 'last'"),
+		Entry("unknown weekday", "last fooday", 5, "unknown weekday or unit 'fooday'"),
+		Entry("invalid time", "today 25:00", 6, "expected a time of day at '25:00'"),
+		Entry("trailing text", "today foo", 6, "expected a time of day at 'foo'"),
+		Entry("at without time", "today at", 8, "expected a time of day after 'at'"),
+	)
+	It("parses layouts starting with a weekday", func() {
+		options.Layouts = libtime.Layouts{stdtime.ANSIC, stdtime.RFC1123}
+		parser := libtime.NewParser(options)
+		result, err := parser.ParseTime(ctx, "Fri Mar  1 10:00:00 2024")
+		Expect(err).To(BeNil())
+		Expect(result.UTC().Format(stdtime.RFC3339)).To(Equal("2024-03-01T10:00:00Z"))
+		result, err = parser.ParseTime(ctx, "Fri, 01 Mar 2024 10:00:00 UTC")
+		Expect(err).To(BeNil())
+		Expect(result.UTC().Format(stdtime.RFC3339)).To(Equal("2024-03-01T10:00:00Z"))
+	})
+	It("reports the layouts for a weekday without modifier", func() {
+		result, err := libtime.NewParser(options).ParseTime(ctx, "friday")
+		Expect(err).NotTo(BeNil())
+		Expect(result).To(BeNil())
+		parseError := asParseError(err)
+		Expect(parseError.Input).To(Equal("friday"))
+		Expect(parseError.Layouts).To(HaveLen(5))
+	})
+	It("resolves days in the location", func() {
+		berlin, err := libtime.LoadLocation(ctx, "Europe/Berlin")
+		Expect(err).To(BeNil())
+		now = stdtime.Date(2024, stdtime.March, 5, 23, 30, 0, 0, stdtime.UTC)
+		options.Location = berlin
+		result, err := libtime.NewParser(options).ParseTime(ctx, "today 09:00")
+		Expect(err).To(BeNil())
+		Expect(result.UTC().Format(stdtime.RFC3339)).To(Equal("2024-03-06T08:00:00Z"))
+	})
+	It("parses german", func() {
+		options.Locale = &libtime.ParserLocaleGerman
+		parser := libtime.NewParser(options)
+		result, err := parser.ParseTime(ctx, "nächsten Freitag um 10:00")
+		Expect(err).To(BeNil())
+		Expect(result.UTC().Format(stdtime.RFC3339)).To(Equal("2024-03-08T10:00:00Z"))
+		result, err = parser.ParseTime(ctx, "vorgestern")
+		Expect(err).To(BeNil())
+		Expect(result.UTC().Format(stdtime.RFC3339)).To(Equal("2024-03-03T00:00:00Z"))
+		Expect(libtime.ParserLocaleByName("DE")).To(Equal(libtime.ParserLocaleGerman))
+		Expect(libtime.ParserLocaleByName("xx")).To(Equal(libtime.ParserLocaleEnglish))
+	})
+	It("parses custom locales", func() {
+		options.Locale = &libtime.ParserLocale{
+			Days: map[string]int{
+				"ayer": -1,
+				"hoy":  0,
+			},
+			Modifiers: map[string]int{
+				"anteanterior": -2,
+			},
+			Weekdays: map[string]libtime.Weekday{
+				"viernes": libtime.Friday,
+			},
+		}
+		parser := libtime.NewParser(options)
+		result, err := parser.ParseTime(ctx, "ayer 08:00")
+		Expect(err).To(BeNil())
+		Expect(result.UTC().Format(stdtime.RFC3339)).To(Equal("2024-03-04T08:00:00Z"))
+		result, err = parser.ParseTime(ctx, "anteanterior viernes")
+		Expect(err).To(BeNil())
+		Expect(result.UTC().Format(stdtime.RFC3339)).To(Equal("2024-02-23T00:00:00Z"))
+		_, err = parser.ParseTime(ctx, "yesterday")
+		Expect(err).NotTo(BeNil())
+	})
+	It("rejects keywords that are not allowed", func() {
+		options.Keywords = []string{libtime.ParserKeywordNow, "today"}
+		parser := libtime.NewParser(options)
+		_, err := parser.ParseTime(ctx, "today")
+		Expect(err).To(BeNil())
+		_, err = parser.ParseTime(ctx, "yesterday")
+		Expect(err).NotTo(BeNil())
+		Expect(asParseError(err).Reason).To(Equal("keyword 'yesterday' is not allowed"))
+	})
+	It("is disabled without locale", func() {
+		options.Locale = nil
+		_, err := libtime.NewParser(options).ParseTime(ctx, "yesterday")
+		Expect(err).NotTo(BeNil())
+		Expect(asParseError(err).Layouts).To(HaveLen(5))
+	})
+	It("parses DateTime and Date", func() {
+		parser := libtime.NewParser(options)
+		dateTime, err := parser.ParseDateTime(ctx, "today 09:00")
+		Expect(err).To(BeNil())
+		Expect(dateTime.Format(stdtime.RFC3339)).To(Equal("2024-03-05T09:00:00Z"))
+		date, err := parser.ParseDate(ctx, "last friday")
+		Expect(err).To(BeNil())
+		Expect(date.String()).To(Equal("2024-03-01"))
+	})
+})
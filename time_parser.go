@@ -0,0 +1,259 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync/atomic"
+	stdtime "time"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/parse"
+)
+
+// ParserKeywordNow is the keyword for the current time, optionally followed by a duration
+// like "NOW-14d".
+const ParserKeywordNow = "NOW"
+
+// ParserOptions configures a Parser. Zero values select the defaults:
+// the layouts of ParseTime, UTC for inputs without offset, all keywords, the package Now
+// and no natural-language phrases.
+type ParserOptions struct {
+	// Layouts are tried after the default layouts. Epoch, strftime and ICU layouts are supported.
+	Layouts Layouts
+	// Location is used for inputs without offset like "2006-01-02 15:04:05"
+	// and for the day boundaries of natural-language phrases.
+	Location *stdtime.Location
+	// Keywords lists the allowed relative keywords like ParserKeywordNow or the first word
+	// of a natural-language phrase like "yesterday" or "last", ignoring case.
+	// Nil allows all keywords, an empty slice none.
+	Keywords []string
+	// Locale enables natural-language phrases like "yesterday" or "last friday". Nil disables them.
+	Locale *ParserLocale
+	// CurrentDateTimeGetter resolves relative keywords.
+	CurrentDateTimeGetter CurrentDateTimeGetter
+}
+
+func (p ParserOptions) withDefaults() ParserOptions {
+	if p.CurrentDateTimeGetter == nil {
+		p.CurrentDateTimeGetter = CurrentDateTimeGetterFunc(func() DateTime {
+			return DateTime(Now())
+		})
+	}
+	return p
+}
+
+//counterfeiter:generate -o mocks/parser.go --fake-name Parser . Parser
+
+// Parser parses times with configurable layouts, location, keywords and clock.
+// ParseTime, ParseDateTime, ParseDate and the types using them parse with the Parser
+// of the context, see ContextWithParser, or the default Parser, see SetDefaultParser.
+type Parser interface {
+	// ParseTime parses a time like ParseTime.
+	ParseTime(ctx context.Context, value interface{}) (*stdtime.Time, error)
+	// ParseDateTime parses a DateTime like ParseDateTime.
+	ParseDateTime(ctx context.Context, value interface{}) (*DateTime, error)
+	// ParseDate parses a Date like ParseDate.
+	ParseDate(ctx context.Context, value interface{}) (*Date, error)
+	// WithLocation returns a copy of the Parser that parses inputs without offset in location.
+	WithLocation(location *stdtime.Location) Parser
+}
+
+// NewParser returns a Parser with the given options.
+// The layouts are translated and tokenized once here, not on every parse.
+func NewParser(options ParserOptions) Parser {
+	goLayouts, layouts := compileParserLayouts(context.Background(), options.Layouts)
+	return &parser{
+		options:   options.withDefaults(),
+		goLayouts: goLayouts,
+		layouts:   layouts,
+	}
+}
+
+var defaultParser atomic.Pointer[Parser]
+
+// initialParser is the default Parser until SetDefaultParser is called.
+var initialParser = NewParser(ParserOptions{})
+
+// SetDefaultParser sets the Parser used without a Parser in the context,
+// like by UnmarshalJSON of DateTime and Date. It affects the whole process,
+// prefer ContextWithParser or the InLocation functions like ParseDateTimeInLocation.
+func SetDefaultParser(parser Parser) {
+	defaultParser.Store(&parser)
+}
+
+// GetDefaultParser returns the Parser used without a Parser in the context.
+// Defaults to NewParser with zero options.
+func GetDefaultParser() Parser {
+	if parser := defaultParser.Load(); parser != nil {
+		return *parser
+	}
+	return initialParser
+}
+
+type parserContextKey struct{}
+
+// ContextWithParser returns a context that makes ParseTime and the functions using it
+// parse with the given Parser.
+func ContextWithParser(ctx context.Context, parser Parser) context.Context {
+	return context.WithValue(ctx, parserContextKey{}, parser)
+}
+
+// ParserFromContext returns the Parser of the context or the default Parser.
+func ParserFromContext(ctx context.Context) Parser {
+	if parser, ok := ctx.Value(parserContextKey{}).(Parser); ok && parser != nil {
+		return parser
+	}
+	return GetDefaultParser()
+}
+
+type parser struct {
+	options ParserOptions
+	// goLayouts are parsed in the location of the options
+	goLayouts []string
+	// layouts have no Go equivalent and are tried after goLayouts
+	layouts []parserLayout
+}
+
+// parserLayout is a layout without Go equivalent, tokenized once by NewParser.
+type parserLayout struct {
+	name  string
+	parse func(ctx context.Context, value string) (*stdtime.Time, error)
+}
+
+// parserNow returns the current time of the clock of the Parser of the context.
+// Parsers not created by NewParser have no accessible clock and fall back to Now.
+func parserNow(ctx context.Context) stdtime.Time {
+	if p, ok := ParserFromContext(ctx).(*parser); ok {
+		return p.options.CurrentDateTimeGetter.Now().Time()
+	}
+	return Now()
+}
+
+func (p *parser) ParseTime(ctx context.Context, value interface{}) (*stdtime.Time, error) {
+	str, err := parse.ParseString(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse value failed")
+	}
+	if strings.HasPrefix(str, ParserKeywordNow) && p.allowsKeyword(ParserKeywordNow) {
+		now := p.options.CurrentDateTimeGetter.Now().Time()
+		if len(str) > len(ParserKeywordNow) {
+			durationString := str[len(ParserKeywordNow):]
+			duration, err := ParseDuration(ctx, durationString)
+			if err != nil {
+				return nil, errors.Wrapf(ctx, err, "parse duration '%s' failed", durationString)
+			}
+			now = now.Add(duration.Duration())
+		}
+		return &now, nil
+	}
+	if t, ok, err := p.parseNatural(ctx, str); ok {
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse time failed")
+		}
+		return t, nil
+	}
+	t, err := parseLayouts(str, p.goLayouts, p.options.Location)
+	if err == nil {
+		return &t, nil
+	}
+	for _, layout := range p.layouts {
+		if t, layoutErr := layout.parse(ctx, str); layoutErr == nil {
+			return t, nil
+		}
+	}
+	var parseError *ParseError
+	if errors.As(err, &parseError) {
+		// the layouts are shared by all calls
+		parseError.Layouts = slices.Clone(parseError.Layouts)
+		for _, layout := range p.layouts {
+			parseError.Layouts = append(parseError.Layouts, layout.name)
+		}
+	}
+	return nil, errors.Wrapf(ctx, err, "parse time failed")
+}
+
+func (p *parser) ParseDateTime(ctx context.Context, value interface{}) (*DateTime, error) {
+	return ParseDateTime(ContextWithParser(ctx, p), value)
+}
+
+func (p *parser) ParseDate(ctx context.Context, value interface{}) (*Date, error) {
+	return ParseDate(ContextWithParser(ctx, p), value)
+}
+
+func (p *parser) WithLocation(location *stdtime.Location) Parser {
+	options := p.options
+	options.Location = location
+	return &parser{
+		options:   options,
+		goLayouts: p.goLayouts,
+		layouts:   p.layouts,
+	}
+}
+
+func (p *parser) allowsKeyword(keyword string) bool {
+	return p.options.Keywords == nil || containsFold(p.options.Keywords, keyword)
+}
+
+// compileParserLayouts returns the default and extra Go layouts, parsed in the location
+// of the options, and the remaining layouts. Strftime and ICU layouts with a Go equivalent
+// are returned as Go layouts, the others are tokenized once.
+func compileParserLayouts(ctx context.Context, extras Layouts) ([]string, []parserLayout) {
+	goLayouts := slices.Clone(parseTimeLayouts)
+	var layouts []parserLayout
+	for _, layout := range extras {
+		if goLayout, ok := layout.goLayout(ctx); ok {
+			goLayouts = append(goLayouts, goLayout.String())
+			continue
+		}
+		layouts = append(layouts, layout.parserLayout(ctx))
+	}
+	return goLayouts, layouts
+}
+
+// parserLayout returns l with tokenized strftime and ICU patterns.
+// Other layouts and invalid patterns parse with Layout.Parse.
+func (l Layout) parserLayout(ctx context.Context) parserLayout {
+	var tokens patternTokens
+	var err error
+	if strftime, ok := strings.CutPrefix(l.String(), strftimeLayoutPrefix); ok {
+		tokens, err = StrftimeLayout(strftime).tokens(ctx)
+	} else if icu, ok := strings.CutPrefix(l.String(), icuLayoutPrefix); ok {
+		tokens, err = ICULayout(icu).tokens(ctx)
+	}
+	if tokens == nil || err != nil {
+		return parserLayout{
+			name: l.String(),
+			parse: func(ctx context.Context, value string) (*stdtime.Time, error) {
+				return l.Parse(ctx, value)
+			},
+		}
+	}
+	return parserLayout{
+		name: l.String(),
+		parse: func(ctx context.Context, value string) (*stdtime.Time, error) {
+			return tokens.parse(ctx, l.String(), value)
+		},
+	}
+}
+
+// goLayout returns the Go layout of l, if it has one.
+func (l Layout) goLayout(ctx context.Context) (Layout, bool) {
+	switch l {
+	case SecondLayout, MilliLayout, MicroLayout, NanoLayout, AutoEpochLayout:
+		return "", false
+	}
+	if strftime, ok := strings.CutPrefix(l.String(), strftimeLayoutPrefix); ok {
+		goLayout, err := StrftimeLayout(strftime).GoLayout(ctx)
+		return goLayout, err == nil
+	}
+	if icu, ok := strings.CutPrefix(l.String(), icuLayoutPrefix); ok {
+		goLayout, err := ICULayout(icu).GoLayout(ctx)
+		return goLayout, err == nil
+	}
+	return l, true
+}
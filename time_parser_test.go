@@ -0,0 +1,190 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	stdtime "time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+	"github.com/bborbe/time/mocks"
+)
+
+var _ = Describe("Parser", func() {
+	var ctx context.Context
+	var berlin *stdtime.Location
+	var now libtime.DateTime
+	var currentDateTimeGetter libtime.CurrentDateTimeGetter
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		berlin, err = libtime.LoadLocation(ctx, "Europe/Berlin")
+		Expect(err).To(BeNil())
+		now = libtime.DateTime(stdtime.Date(2024, stdtime.March, 5, 12, 0, 0, 0, stdtime.UTC))
+		currentDateTimeGetter = libtime.CurrentDateTimeGetterFunc(func() libtime.DateTime {
+			return now
+		})
+	})
+	Context("default options", func() {
+		var parser libtime.Parser
+		BeforeEach(func() {
+			parser = libtime.NewParser(libtime.ParserOptions{})
+			libtime.Now = func() stdtime.Time {
+				return now.Time()
+			}
+			DeferCleanup(func() {
+				libtime.Now = stdtime.Now
+			})
+		})
+		DescribeTable("parses like ParseTime",
+			func(input string) {
+				expected, err := libtime.ParseTime(ctx, input)
+				Expect(err).To(BeNil())
+				result, err := parser.ParseTime(ctx, input)
+				Expect(err).To(BeNil())
+				Expect(*result).To(Equal(*expected))
+			},
+			Entry("rfc3339", "2024-03-05T10:00:00Z"),
+			Entry("rfc3339 nano", "2024-03-05T10:00:00.5+02:00"),
+			Entry("minutes", "2024-03-05T10:00Z"),
+			Entry("date time", "2024-03-05 10:00:00"),
+			Entry("date", "2024-03-05"),
+			Entry("now", "NOW"),
+			Entry("now minus", "NOW-1d"),
+		)
+	})
+	It("parses zone-less inputs in the location", func() {
+		parser := libtime.NewParser(libtime.ParserOptions{Location: berlin})
+		result, err := parser.ParseTime(ctx, "2024-03-05 10:00:00")
+		Expect(err).To(BeNil())
+		Expect(result.UTC()).To(Equal(stdtime.Date(2024, stdtime.March, 5, 9, 0, 0, 0, stdtime.UTC)))
+
+		result, err = parser.ParseTime(ctx, "2024-03-05T10:00:00Z")
+		Expect(err).To(BeNil())
+		Expect(result.UTC()).To(Equal(stdtime.Date(2024, stdtime.March, 5, 10, 0, 0, 0, stdtime.UTC)))
+
+		date, err := parser.ParseDate(ctx, "2024-03-05")
+		Expect(err).To(BeNil())
+		Expect(date.String()).To(Equal("2024-03-05"))
+	})
+	It("parses with extra layouts", func() {
+		parser := libtime.NewParser(libtime.ParserOptions{
+			Layouts: libtime.Layouts{
+				"02.01.2006 15:04",
+				libtime.StrftimeLayout("%d/%m/%Y").Layout(),
+				libtime.SecondLayout,
+			},
+			Location: berlin,
+		})
+		result, err := parser.ParseTime(ctx, "05.03.2024 10:00")
+		Expect(err).To(BeNil())
+		Expect(result.UTC()).To(Equal(stdtime.Date(2024, stdtime.March, 5, 9, 0, 0, 0, stdtime.UTC)))
+
+		result, err = parser.ParseTime(ctx, "05/03/2024")
+		Expect(err).To(BeNil())
+		Expect(result.UTC()).To(Equal(stdtime.Date(2024, stdtime.March, 4, 23, 0, 0, 0, stdtime.UTC)))
+
+		result, err = parser.ParseTime(ctx, "1709632800")
+		Expect(err).To(BeNil())
+		Expect(result.UTC()).To(Equal(stdtime.Date(2024, stdtime.March, 5, 10, 0, 0, 0, stdtime.UTC)))
+
+		_, err = parser.ParseTime(ctx, "yesterday")
+		Expect(err).NotTo(BeNil())
+		var parseError *libtime.ParseError
+		Expect(stderrors.As(err, &parseError)).To(BeTrue())
+		Expect(parseError.Layouts).To(HaveLen(8))
+		Expect(parseError.Layouts[5]).To(Equal("02.01.2006 15:04"))
+		Expect(parseError.Layouts[7]).To(Equal("second"))
+	})
+	It("parses repeatedly with precompiled layouts", func() {
+		parser := libtime.NewParser(libtime.ParserOptions{
+			Layouts: libtime.Layouts{libtime.StrftimeLayout("%G-W%V-%u").Layout()},
+		})
+		for i := 0; i < 3; i++ {
+			result, err := parser.WithLocation(berlin).ParseTime(ctx, "2025-W01-1")
+			Expect(err).To(BeNil())
+			Expect(result.Format(stdtime.DateOnly)).To(Equal("2024-12-30"))
+
+			_, err = parser.ParseTime(ctx, "banana")
+			var parseError *libtime.ParseError
+			Expect(stderrors.As(err, &parseError)).To(BeTrue())
+			Expect(parseError.Layouts).To(HaveLen(6))
+		}
+	})
+	It("returns the same default parser", func() {
+		Expect(libtime.GetDefaultParser()).To(BeIdenticalTo(libtime.GetDefaultParser()))
+	})
+	It("resolves keywords with the clock", func() {
+		parser := libtime.NewParser(libtime.ParserOptions{
+			CurrentDateTimeGetter: currentDateTimeGetter,
+		})
+		result, err := parser.ParseTime(ctx, "NOW-1h")
+		Expect(err).To(BeNil())
+		Expect(*result).To(Equal(now.Time().Add(-stdtime.Hour)))
+
+		dateTime, err := parser.ParseDateTime(ctx, "NOW")
+		Expect(err).To(BeNil())
+		Expect(*dateTime).To(Equal(now))
+	})
+	It("rejects keywords that are not allowed", func() {
+		parser := libtime.NewParser(libtime.ParserOptions{
+			Keywords:              []string{},
+			CurrentDateTimeGetter: currentDateTimeGetter,
+		})
+		result, err := parser.ParseTime(ctx, "NOW")
+		Expect(err).NotTo(BeNil())
+		Expect(result).To(BeNil())
+	})
+	Context("injection", func() {
+		var parser libtime.Parser
+		BeforeEach(func() {
+			parser = libtime.NewParser(libtime.ParserOptions{
+				Location:              berlin,
+				CurrentDateTimeGetter: currentDateTimeGetter,
+			})
+		})
+		It("uses the parser of the context", func() {
+			ctx = libtime.ContextWithParser(ctx, parser)
+			Expect(libtime.ParserFromContext(ctx)).To(Equal(parser))
+
+			dateTime, err := libtime.ParseDateTime(ctx, "2024-03-05 10:00:00")
+			Expect(err).To(BeNil())
+			Expect(dateTime.UTC().Hour()).To(Equal(9))
+
+			unixTime, err := libtime.ParseUnixTime(ctx, "NOW")
+			Expect(err).To(BeNil())
+			Expect(unixTime.Time()).To(Equal(now.Time()))
+		})
+		It("passes the context to the parser", func() {
+			fakeParser := &mocks.Parser{}
+			t := now.Time()
+			fakeParser.ParseTimeReturns(&t, nil)
+			ctx = libtime.ContextWithParser(ctx, fakeParser)
+			date, err := libtime.ParseDate(ctx, "anything")
+			Expect(err).To(BeNil())
+			Expect(date.String()).To(Equal("2024-03-05"))
+			Expect(fakeParser.ParseTimeCallCount()).To(Equal(1))
+			_, value := fakeParser.ParseTimeArgsForCall(0)
+			Expect(value).To(Equal("anything"))
+		})
+		It("uses the default parser for json", func() {
+			libtime.SetDefaultParser(parser)
+			DeferCleanup(func() {
+				libtime.SetDefaultParser(libtime.NewParser(libtime.ParserOptions{}))
+			})
+			Expect(libtime.GetDefaultParser()).To(Equal(parser))
+			Expect(libtime.ParserFromContext(ctx)).To(Equal(parser))
+
+			var dateTime libtime.DateTime
+			Expect(json.Unmarshal([]byte(`"2024-03-05 10:00:00"`), &dateTime)).To(Succeed())
+			Expect(dateTime.UTC().Hour()).To(Equal(9))
+		})
+	})
+})
@@ -10,8 +10,13 @@ import (
 
 	"github.com/bborbe/errors"
 	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
 )
 
+var _ yaml.Marshaler = TimeRange{}
+
+var _ yaml.Unmarshaler = (*TimeRange)(nil)
+
 type TimeRange struct {
 	From  stdtime.Time `json:"from,omitempty"`
 	Until stdtime.Time `json:"until,omitempty"`
@@ -36,6 +41,22 @@ func (r TimeRange) Ptr() *TimeRange {
 	return &r
 }
 
+// MarshalYAML implements yaml.Marshaler and writes from and until. Zero sides are omitted.
+func (r TimeRange) MarshalYAML() (interface{}, error) {
+	return yamlRange[DateTime]{From: DateTime(r.From), Until: DateTime(r.Until)}, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. From and until accept the same values
+// as DateTime.UnmarshalYAML.
+func (r *TimeRange) UnmarshalYAML(node *yaml.Node) error {
+	result, err := unmarshalYAMLRange[DateTime](node)
+	if err != nil {
+		return errors.Wrapf(context.Background(), err, "unmarshal TimeRange failed")
+	}
+	*r = TimeRange{From: result.From.Time(), Until: result.Until.Time()}
+	return nil
+}
+
 // DayTimeRange creates a TimeRange covering the entire day containing the given time.
 // The range spans from 00:00:00.000000000 to 23:59:59.999999999 of that day.
 func DayTimeRange(t stdtime.Time) TimeRange {
@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/parse"
+	"github.com/bborbe/validation"
+)
+
+// SQLDateRange is a DateRange stored in a Postgres daterange column.
+// Unlike DateRange it keeps NULL, "empty" and the unbounded range "(,)" apart.
+type SQLDateRange struct {
+	Range DateRange
+	// Empty is the range "empty" without any day. Range is ignored.
+	Empty bool
+	// Valid is false for NULL. A valid Range without From and Until is "(,)".
+	Valid bool
+}
+
+var _ sql.Scanner = (*SQLDateRange)(nil)
+
+var _ driver.Valuer = SQLDateRange{}
+
+func (r SQLDateRange) Ptr() *SQLDateRange {
+	return &r
+}
+
+// ParseSQLDateRange parses a Postgres daterange literal like "[2024-01-01,2024-02-01)".
+// Exclusive bounds are converted to the inclusive From and Until and unbounded sides
+// become zero dates. "empty" and ranges without any day are Empty, "(,)" is a valid
+// range without From and Until and a lower bound after the upper bound is an error.
+func ParseSQLDateRange(ctx context.Context, value interface{}) (*SQLDateRange, error) {
+	str, err := parse.ParseString(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse value failed")
+	}
+	sqlRange, err := parseSQLRange(ctx, str)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse range failed")
+	}
+	if sqlRange.empty {
+		return &SQLDateRange{Empty: true, Valid: true}, nil
+	}
+	if sqlRange.lower == "" && sqlRange.upper == "" {
+		return &SQLDateRange{Valid: true}, nil
+	}
+	var result DateRange
+	if sqlRange.lower != "" {
+		t, err := scanSQLTime(ctx, sqlRange.lower)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse lower bound failed")
+		}
+		result.From = ToDate(t)
+	}
+	if sqlRange.upper != "" {
+		t, err := scanSQLTime(ctx, sqlRange.upper)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse upper bound failed")
+		}
+		result.Until = ToDate(t)
+	}
+	if sqlRange.lower != "" && sqlRange.upper != "" &&
+		result.From.Time().After(result.Until.Time()) {
+		return nil, errors.Wrapf(
+			ctx,
+			validation.Error,
+			"lower bound of range '%s' is after upper bound",
+			str,
+		)
+	}
+	if sqlRange.lower != "" && !sqlRange.lowerInclusive {
+		result.From = ToDate(result.From.Time().AddDate(0, 0, 1))
+	}
+	if sqlRange.upper != "" && !sqlRange.upperInclusive {
+		result.Until = ToDate(result.Until.Time().AddDate(0, 0, -1))
+	}
+	if sqlRange.lower != "" && sqlRange.upper != "" &&
+		result.From.Time().After(result.Until.Time()) {
+		return &SQLDateRange{Empty: true, Valid: true}, nil
+	}
+	return &SQLDateRange{Range: result, Valid: true}, nil
+}
+
+// SQLRange returns "empty" for Empty and Range.SQLRange otherwise.
+func (r SQLDateRange) SQLRange() string {
+	if r.Empty {
+		return "empty"
+	}
+	return r.Range.SQLRange()
+}
+
+// Scan implements sql.Scanner like ParseSQLDateRange. NULL scans into the zero SQLDateRange.
+func (r *SQLDateRange) Scan(src interface{}) error {
+	ctx := context.Background()
+	switch v := src.(type) {
+	case nil:
+		*r = SQLDateRange{}
+		return nil
+	case []byte:
+		return r.Scan(string(v))
+	case string:
+		result, err := ParseSQLDateRange(ctx, v)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "scan date range failed")
+		}
+		*r = *result
+		return nil
+	default:
+		return errors.Errorf(ctx, "can not scan %T into date range", src)
+	}
+}
+
+// Value implements driver.Valuer and returns SQLRange or NULL if not Valid.
+func (r SQLDateRange) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	return r.SQLRange(), nil
+}
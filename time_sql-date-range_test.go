@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("SQLDateRange", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	DescribeTable("ParseSQLDateRange",
+		func(input string, expectedFrom string, expectedUntil string, expectError bool) {
+			result, err := libtime.ParseSQLDateRange(ctx, input)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(result).NotTo(BeNil())
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Empty).To(BeFalse())
+			Expect(result.Range.From.Time().Format(time.DateOnly)).To(Equal(expectedFrom))
+			Expect(result.Range.Until.Time().Format(time.DateOnly)).To(Equal(expectedUntil))
+		},
+		Entry("canonical", "[2024-01-01,2024-02-01)", "2024-01-01", "2024-01-31", false),
+		Entry("inclusive", "[2024-01-01,2024-01-31]", "2024-01-01", "2024-01-31", false),
+		Entry("exclusive lower", "(2023-12-31,2024-02-01)", "2024-01-01", "2024-01-31", false),
+		Entry("unbounded lower", "(,2024-02-01)", "0001-01-01", "2024-01-31", false),
+		Entry("unbounded upper", "[2024-01-01,)", "2024-01-01", "0001-01-01", false),
+		Entry("unbounded", "(,)", "0001-01-01", "0001-01-01", false),
+		Entry("infinity", "[2024-01-01,infinity)", "2024-01-01", "0001-01-01", false),
+		Entry("quoted", `["2024-01-01","2024-02-01")`, "2024-01-01", "2024-01-31", false),
+		Entry("missing bracket", "2024-01-01,2024-02-01", "", "", true),
+		Entry("missing comma", "[2024-01-01)", "", "", true),
+		Entry("invalid date", "[banana,2024-02-01)", "", "", true),
+		Entry("inverted", "[2024-02-01,2024-01-01]", "", "", true),
+		Entry("inverted exclusive", "(2024-02-01,2024-01-01)", "", "", true),
+	)
+	DescribeTable("parses ranges without any day as empty",
+		func(input string) {
+			result, err := libtime.ParseSQLDateRange(ctx, input)
+			Expect(err).To(BeNil())
+			Expect(*result).To(Equal(libtime.SQLDateRange{Empty: true, Valid: true}))
+		},
+		Entry("empty", "empty"),
+		Entry("no day", "[2024-01-01,2024-01-01)"),
+	)
+	DescribeTable("SQLRange",
+		func(input libtime.SQLDateRange, expected string) {
+			Expect(input.SQLRange()).To(Equal(expected))
+		},
+		Entry(
+			"month",
+			libtime.SQLDateRange{Range: libtime.MonthDateRange(ParseDate("2024-01-15")), Valid: true},
+			"[2024-01-01,2024-02-01)",
+		),
+		Entry("empty", libtime.SQLDateRange{Empty: true, Valid: true}, "empty"),
+		Entry("unbounded", libtime.SQLDateRange{Valid: true}, "(,)"),
+	)
+	DescribeTable("round trips through Value and Scan",
+		func(input libtime.SQLDateRange, expectedValue interface{}) {
+			value, err := input.Value()
+			Expect(err).To(BeNil())
+			if expectedValue == nil {
+				Expect(value).To(BeNil())
+			} else {
+				Expect(value).To(Equal(expectedValue))
+			}
+			result := libtime.SQLDateRange{
+				Range: libtime.MonthDateRange(ParseDate("2023-06-15")),
+				Valid: true,
+			}
+			Expect(result.Scan(value)).To(Succeed())
+			Expect(result).To(Equal(input))
+		},
+		Entry("null", libtime.SQLDateRange{}, nil),
+		Entry("empty", libtime.SQLDateRange{Empty: true, Valid: true}, "empty"),
+		Entry("unbounded", libtime.SQLDateRange{Valid: true}, "(,)"),
+		Entry(
+			"unbounded lower",
+			libtime.SQLDateRange{Range: libtime.DateRange{Until: ParseDate("2024-01-31")}, Valid: true},
+			"(,2024-02-01)",
+		),
+		Entry(
+			"bounded",
+			libtime.SQLDateRange{
+				Range: libtime.DateRange{From: ParseDate("2024-01-01"), Until: ParseDate("2024-01-31")},
+				Valid: true,
+			},
+			"[2024-01-01,2024-02-01)",
+		),
+	)
+})
+
+var _ = Describe("DateRange SQL", func() {
+	DescribeTable("SQLRange",
+		func(input libtime.DateRange, expected string) {
+			Expect(input.SQLRange()).To(Equal(expected))
+		},
+		Entry("month", libtime.MonthDateRange(ParseDate("2024-01-15")), "[2024-01-01,2024-02-01)"),
+		Entry(
+			"unbounded lower",
+			libtime.DateRange{Until: ParseDate("2024-01-31")},
+			"(,2024-02-01)",
+		),
+		Entry(
+			"unbounded upper",
+			libtime.DateRange{From: ParseDate("2024-01-01")},
+			"[2024-01-01,)",
+		),
+	)
+	It("scans and values", func() {
+		input := libtime.MonthDateRange(ParseDate("2024-01-15"))
+		value, err := input.Value()
+		Expect(err).To(BeNil())
+		var result libtime.DateRange
+		Expect(result.Scan([]byte(value.(string)))).To(Succeed())
+		Expect(result.From.String()).To(Equal("2024-01-01"))
+		Expect(result.Until.String()).To(Equal("2024-01-31"))
+	})
+	It("stores zero as NULL and scans NULL and unbounded as zero", func() {
+		value, err := libtime.DateRange{}.Value()
+		Expect(err).To(BeNil())
+		Expect(value).To(BeNil())
+		result := libtime.MonthDateRange(ParseDate("2024-01-15"))
+		Expect(result.Scan(nil)).To(Succeed())
+		Expect(result).To(Equal(libtime.DateRange{}))
+		result = libtime.MonthDateRange(ParseDate("2024-01-15"))
+		Expect(result.Scan("(,)")).To(Succeed())
+		Expect(result).To(Equal(libtime.DateRange{}))
+	})
+	It("fails to scan empty", func() {
+		var result libtime.DateRange
+		Expect(result.Scan("empty")).NotTo(Succeed())
+	})
+})
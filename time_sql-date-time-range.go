@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/parse"
+	"github.com/bborbe/validation"
+)
+
+// SQLDateTimeRange is a DateTimeRange stored in a Postgres tstzrange or tsrange column.
+// Unlike DateTimeRange it keeps NULL, "empty" and the unbounded range "(,)" apart.
+type SQLDateTimeRange struct {
+	Range DateTimeRange
+	// Empty is the range "empty" without any time. Range is ignored.
+	Empty bool
+	// Valid is false for NULL. A valid Range without From and Until is "(,)".
+	Valid bool
+}
+
+var _ sql.Scanner = (*SQLDateTimeRange)(nil)
+
+var _ driver.Valuer = SQLDateTimeRange{}
+
+func (r SQLDateTimeRange) Ptr() *SQLDateTimeRange {
+	return &r
+}
+
+// ParseSQLDateTimeRange parses a Postgres tstzrange or tsrange literal
+// like "[2024-01-01 00:00:00+00,2024-02-01 00:00:00+00)".
+// Exclusive bounds are converted to the inclusive From and Until by one nanosecond
+// and unbounded sides become zero times. "empty" and ranges without any time are Empty,
+// "(,)" is a valid range without From and Until and a lower bound after the upper bound
+// is an error.
+func ParseSQLDateTimeRange(ctx context.Context, value interface{}) (*SQLDateTimeRange, error) {
+	str, err := parse.ParseString(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse value failed")
+	}
+	sqlRange, err := parseSQLRange(ctx, str)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse range failed")
+	}
+	if sqlRange.empty {
+		return &SQLDateTimeRange{Empty: true, Valid: true}, nil
+	}
+	if sqlRange.lower == "" && sqlRange.upper == "" {
+		return &SQLDateTimeRange{Valid: true}, nil
+	}
+	var result DateTimeRange
+	if sqlRange.lower != "" {
+		t, err := scanSQLTime(ctx, sqlRange.lower)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse lower bound failed")
+		}
+		result.From = DateTime(t)
+	}
+	if sqlRange.upper != "" {
+		t, err := scanSQLTime(ctx, sqlRange.upper)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse upper bound failed")
+		}
+		result.Until = DateTime(t)
+	}
+	if sqlRange.lower != "" && sqlRange.upper != "" && result.From.After(result.Until) {
+		return nil, errors.Wrapf(
+			ctx,
+			validation.Error,
+			"lower bound of range '%s' is after upper bound",
+			str,
+		)
+	}
+	if sqlRange.lower != "" && !sqlRange.lowerInclusive {
+		result.From = result.From.Add(Nanosecond)
+	}
+	if sqlRange.upper != "" && !sqlRange.upperInclusive {
+		result.Until = result.Until.Add(-Nanosecond)
+	}
+	if sqlRange.lower != "" && sqlRange.upper != "" && result.From.After(result.Until) {
+		return &SQLDateTimeRange{Empty: true, Valid: true}, nil
+	}
+	return &SQLDateTimeRange{Range: result, Valid: true}, nil
+}
+
+// SQLRange returns "empty" for Empty and Range.SQLRange otherwise.
+func (r SQLDateTimeRange) SQLRange() string {
+	if r.Empty {
+		return "empty"
+	}
+	return r.Range.SQLRange()
+}
+
+// Scan implements sql.Scanner like ParseSQLDateTimeRange. NULL scans into the zero SQLDateTimeRange.
+func (r *SQLDateTimeRange) Scan(src interface{}) error {
+	ctx := context.Background()
+	switch v := src.(type) {
+	case nil:
+		*r = SQLDateTimeRange{}
+		return nil
+	case []byte:
+		return r.Scan(string(v))
+	case string:
+		result, err := ParseSQLDateTimeRange(ctx, v)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "scan date time range failed")
+		}
+		*r = *result
+		return nil
+	default:
+		return errors.Errorf(ctx, "can not scan %T into date time range", src)
+	}
+}
+
+// Value implements driver.Valuer and returns SQLRange or NULL if not Valid.
+func (r SQLDateTimeRange) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	return r.SQLRange(), nil
+}
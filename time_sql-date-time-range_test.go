@@ -0,0 +1,179 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("SQLDateTimeRange", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	DescribeTable("ParseSQLDateTimeRange",
+		func(input string, expectedFrom string, expectedUntil string, expectError bool) {
+			result, err := libtime.ParseSQLDateTimeRange(ctx, input)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(result).NotTo(BeNil())
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Empty).To(BeFalse())
+			Expect(result.Range.From.UTC().String()).To(Equal(expectedFrom))
+			Expect(result.Range.Until.UTC().String()).To(Equal(expectedUntil))
+		},
+		Entry(
+			"postgres output",
+			`["2024-01-01 00:00:00+00","2024-02-01 00:00:00+00")`,
+			"2024-01-01T00:00:00Z",
+			"2024-01-31T23:59:59.999999999Z",
+			false,
+		),
+		Entry(
+			"inclusive",
+			"[2024-01-01T00:00:00Z,2024-01-01T10:00:00Z]",
+			"2024-01-01T00:00:00Z",
+			"2024-01-01T10:00:00Z",
+			false,
+		),
+		Entry(
+			"exclusive lower",
+			"(2024-01-01T00:00:00Z,2024-01-01T10:00:00Z]",
+			"2024-01-01T00:00:00.000000001Z",
+			"2024-01-01T10:00:00Z",
+			false,
+		),
+		Entry(
+			"offset",
+			`["2024-01-01 01:00:00+01",)`,
+			"2024-01-01T00:00:00Z",
+			"0001-01-01T00:00:00Z",
+			false,
+		),
+		Entry("unbounded", "(,)", "0001-01-01T00:00:00Z", "0001-01-01T00:00:00Z", false),
+		Entry("invalid", "[banana,)", "", "", true),
+		Entry("three bounds", "[2024-01-01,2024-01-02,2024-01-03)", "", "", true),
+		Entry("unterminated quote", `["2024-01-01,)`, "", "", true),
+		Entry(
+			"inverted",
+			"[2024-01-02T00:00:00Z,2024-01-01T00:00:00Z]",
+			"",
+			"",
+			true,
+		),
+	)
+	DescribeTable("parses ranges without any time as empty",
+		func(input string) {
+			result, err := libtime.ParseSQLDateTimeRange(ctx, input)
+			Expect(err).To(BeNil())
+			Expect(*result).To(Equal(libtime.SQLDateTimeRange{Empty: true, Valid: true}))
+		},
+		Entry("empty", "empty"),
+		Entry("no time", "[2024-01-01T00:00:00Z,2024-01-01T00:00:00Z)"),
+	)
+	DescribeTable("SQLRange",
+		func(input libtime.SQLDateTimeRange, expected string) {
+			Expect(input.SQLRange()).To(Equal(expected))
+		},
+		Entry(
+			"day",
+			libtime.SQLDateTimeRange{
+				Range: libtime.DayDateTimeRange(ParseDateTime("2024-01-15T12:00:00Z")),
+				Valid: true,
+			},
+			"[2024-01-15T00:00:00Z,2024-01-16T00:00:00Z)",
+		),
+		Entry("empty", libtime.SQLDateTimeRange{Empty: true, Valid: true}, "empty"),
+		Entry("unbounded", libtime.SQLDateTimeRange{Valid: true}, "(,)"),
+	)
+	DescribeTable("round trips through Value and Scan",
+		func(input libtime.SQLDateTimeRange) {
+			value, err := input.Value()
+			Expect(err).To(BeNil())
+			Expect(value == nil).To(Equal(!input.Valid))
+			result := libtime.SQLDateTimeRange{
+				Range: libtime.DayDateTimeRange(ParseDateTime("2023-06-15T12:00:00Z")),
+				Valid: true,
+			}
+			Expect(result.Scan(value)).To(Succeed())
+			Expect(result.Range.From.Equal(input.Range.From)).To(BeTrue())
+			Expect(result.Range.Until.Equal(input.Range.Until)).To(BeTrue())
+			Expect(result.Empty).To(Equal(input.Empty))
+			Expect(result.Valid).To(Equal(input.Valid))
+		},
+		Entry("null", libtime.SQLDateTimeRange{}),
+		Entry("empty", libtime.SQLDateTimeRange{Empty: true, Valid: true}),
+		Entry("unbounded", libtime.SQLDateTimeRange{Valid: true}),
+		Entry("unbounded upper", libtime.SQLDateTimeRange{
+			Range: libtime.DateTimeRange{From: ParseDateTime("2024-01-15T08:00:00Z")},
+			Valid: true,
+		}),
+		Entry("day", libtime.SQLDateTimeRange{
+			Range: libtime.DayDateTimeRange(ParseDateTime("2024-01-15T12:00:00Z")),
+			Valid: true,
+		}),
+	)
+})
+
+var _ = Describe("DateTimeRange SQL", func() {
+	DescribeTable("SQLRange",
+		func(input libtime.DateTimeRange, expected string) {
+			Expect(input.SQLRange()).To(Equal(expected))
+		},
+		Entry(
+			"day",
+			libtime.DayDateTimeRange(ParseDateTime("2024-01-15T12:00:00Z")),
+			"[2024-01-15T00:00:00Z,2024-01-16T00:00:00Z)",
+		),
+		Entry(
+			"inclusive until",
+			libtime.DateTimeRange{
+				From:  ParseDateTime("2024-01-15T08:00:00Z"),
+				Until: ParseDateTime("2024-01-15T10:00:00Z"),
+			},
+			"[2024-01-15T08:00:00Z,2024-01-15T10:00:00Z]",
+		),
+		Entry(
+			"unbounded lower",
+			libtime.DateTimeRange{Until: ParseDateTime("2024-01-15T10:00:00Z")},
+			"(,2024-01-15T10:00:00Z]",
+		),
+		Entry(
+			"unbounded upper",
+			libtime.DateTimeRange{From: ParseDateTime("2024-01-15T08:00:00Z")},
+			"[2024-01-15T08:00:00Z,)",
+		),
+	)
+	DescribeTable("round trips through Value and Scan",
+		func(input libtime.DateTimeRange) {
+			value, err := input.Value()
+			Expect(err).To(BeNil())
+			Expect(value == nil).To(Equal(input == libtime.DateTimeRange{}))
+			result := libtime.DayDateTimeRange(ParseDateTime("2023-06-15T12:00:00Z"))
+			Expect(result.Scan(value)).To(Succeed())
+			Expect(result.From.Equal(input.From)).To(BeTrue())
+			Expect(result.Until.Equal(input.Until)).To(BeTrue())
+		},
+		Entry("null", libtime.DateTimeRange{}),
+		Entry(
+			"unbounded lower",
+			libtime.DateTimeRange{Until: ParseDateTime("2024-01-15T10:00:00Z")},
+		),
+		Entry("day", libtime.DayDateTimeRange(ParseDateTime("2024-01-15T12:00:00Z"))),
+	)
+	It("fails to scan empty", func() {
+		var result libtime.DateTimeRange
+		Expect(result.Scan("empty")).NotTo(Succeed())
+	})
+})
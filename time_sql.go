@@ -0,0 +1,210 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	stdtime "time"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/validation"
+)
+
+// sqlTimeLayouts are the text formats drivers use for DATE, TIMESTAMP and TIMESTAMPTZ columns.
+var sqlTimeLayouts = Layouts{
+	RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	DateLayout,
+}
+
+// sqlIntervalRegexp matches Postgres intervals like "1 day 02:03:04.5" or "-01:30:00".
+var sqlIntervalRegexp = regexp.MustCompile(
+	`^(?:([+-]?\d+) (years?|mons?|days?))?(?: ?([+-]?\d+) (mons?|days?))?(?: ?([+-]?\d+) (days?))?(?: ?([+-])?(\d+):(\d{2}):(\d{2}(?:\.\d+)?))?$`,
+)
+
+// scanSQLTime converts a value returned by a database/sql driver into a time.
+// NULL becomes the zero time.
+func scanSQLTime(ctx context.Context, src interface{}) (stdtime.Time, error) {
+	switch v := src.(type) {
+	case nil:
+		return stdtime.Time{}, nil
+	case stdtime.Time:
+		return v, nil
+	case []byte:
+		return scanSQLTime(ctx, string(v))
+	case string:
+		t, err := sqlTimeLayouts.Parse(ctx, v)
+		if err != nil {
+			return stdtime.Time{}, errors.Wrapf(ctx, err, "parse '%s' failed", v)
+		}
+		return *t, nil
+	default:
+		return stdtime.Time{}, errors.Errorf(ctx, "can not scan %T into time", src)
+	}
+}
+
+// parseSQLInterval parses Postgres intervals. Months and years are rejected
+// because they have no fixed duration.
+func parseSQLInterval(ctx context.Context, value string) (*Duration, error) {
+	matches := sqlIntervalRegexp.FindStringSubmatch(value)
+	if matches == nil || value == "" {
+		return nil, errors.Errorf(ctx, "'%s' is not an interval", value)
+	}
+	var result Duration
+	for i := 1; i <= 5; i += 2 {
+		if matches[i] == "" {
+			continue
+		}
+		if !strings.HasPrefix(matches[i+1], "day") {
+			return nil, errors.Errorf(ctx, "interval '%s' contains %s", value, matches[i+1])
+		}
+		days, err := strconv.ParseInt(matches[i], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse days failed")
+		}
+		result += Duration(days) * Day
+	}
+	if matches[8] != "" {
+		hours, err := strconv.ParseInt(matches[8], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse hours failed")
+		}
+		minutes, err := strconv.ParseInt(matches[9], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse minutes failed")
+		}
+		secondsStr, fractionStr, _ := strings.Cut(matches[10], ".")
+		seconds, err := strconv.ParseInt(secondsStr, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse seconds failed")
+		}
+		var fraction int64
+		if fractionStr != "" {
+			fractionStr = (fractionStr + "000000000")[:9]
+			fraction, err = strconv.ParseInt(fractionStr, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(ctx, err, "parse fraction failed")
+			}
+		}
+		clock := Duration(hours)*Hour + Duration(minutes)*Minute + Duration(seconds)*Second +
+			Duration(fraction)
+		if matches[7] == "-" {
+			clock = -clock
+		}
+		result += clock
+	}
+	return &result, nil
+}
+
+// sqlRange is a parsed Postgres range literal like "[2024-01-01,2024-02-01)".
+// Unbounded sides have an empty bound.
+type sqlRange struct {
+	empty          bool
+	lower          string
+	upper          string
+	lowerInclusive bool
+	upperInclusive bool
+}
+
+// parseSQLRange parses the Postgres range literal syntax including "empty",
+// unbounded sides and quoted bounds. "infinity" bounds are treated as unbounded.
+func parseSQLRange(ctx context.Context, value string) (*sqlRange, error) {
+	value = strings.TrimSpace(value)
+	if strings.EqualFold(value, "empty") {
+		return &sqlRange{empty: true}, nil
+	}
+	if len(value) < 3 {
+		return nil, errors.Errorf(ctx, "'%s' is not a range", value)
+	}
+	var result sqlRange
+	switch value[0] {
+	case '[':
+		result.lowerInclusive = true
+	case '(':
+	default:
+		return nil, errors.Errorf(ctx, "range '%s' must start with '[' or '('", value)
+	}
+	switch value[len(value)-1] {
+	case ']':
+		result.upperInclusive = true
+	case ')':
+	default:
+		return nil, errors.Errorf(ctx, "range '%s' must end with ']' or ')'", value)
+	}
+	bounds, err := splitSQLRangeBounds(ctx, value[1:len(value)-1])
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse range '%s' failed", value)
+	}
+	result.lower, result.upper = bounds[0], bounds[1]
+	for _, bound := range []*string{&result.lower, &result.upper} {
+		if strings.EqualFold(*bound, "infinity") || strings.EqualFold(*bound, "-infinity") {
+			*bound = ""
+		}
+	}
+	return &result, nil
+}
+
+// splitSQLRangeBounds splits the inner part of a range literal at the comma
+// and removes quotes and backslash escapes from the bounds.
+func splitSQLRangeBounds(ctx context.Context, value string) ([2]string, error) {
+	var result [2]string
+	var builder strings.Builder
+	index := 0
+	quoted := false
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '\\' && i+1 < len(value):
+			i++
+			builder.WriteByte(value[i])
+		case c == '"' && quoted && i+1 < len(value) && value[i+1] == '"':
+			i++
+			builder.WriteByte('"')
+		case c == '"':
+			quoted = !quoted
+		case c == ',' && !quoted:
+			if index > 0 {
+				return result, errors.Wrapf(ctx, validation.Error, "range has more than two bounds")
+			}
+			result[index] = builder.String()
+			builder.Reset()
+			index++
+		default:
+			builder.WriteByte(c)
+		}
+	}
+	if quoted {
+		return result, errors.Wrapf(ctx, validation.Error, "unterminated quote")
+	}
+	if index != 1 {
+		return result, errors.Wrapf(ctx, validation.Error, "range needs two bounds")
+	}
+	result[index] = builder.String()
+	return result, nil
+}
+
+// formatSQLRange returns a Postgres range literal. Empty bounds are unbounded.
+func formatSQLRange(lower, upper string, upperInclusive bool) string {
+	var builder strings.Builder
+	if lower == "" {
+		builder.WriteString("(")
+	} else {
+		builder.WriteString("[")
+		builder.WriteString(lower)
+	}
+	builder.WriteString(",")
+	builder.WriteString(upper)
+	if upper != "" && upperInclusive {
+		builder.WriteString("]")
+	} else {
+		builder.WriteString(")")
+	}
+	return builder.String()
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+// echoDriver is an in-memory database/sql driver. Every query returns one row
+// with the converted query arguments, so values make a round trip through database/sql.
+type echoDriver struct{}
+
+func (echoDriver) Open(name string) (driver.Conn, error) { return echoConn{}, nil }
+
+type echoConn struct{}
+
+func (echoConn) Prepare(query string) (driver.Stmt, error) { return echoStmt{}, nil }
+func (echoConn) Close() error                              { return nil }
+func (echoConn) Begin() (driver.Tx, error)                 { return nil, driver.ErrSkip }
+
+type echoStmt struct{}
+
+func (echoStmt) Close() error  { return nil }
+func (echoStmt) NumInput() int { return -1 }
+func (echoStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(0), nil
+}
+func (echoStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &echoRows{values: args}, nil
+}
+
+type echoRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (r *echoRows) Columns() []string { return make([]string, len(r.values)) }
+func (r *echoRows) Close() error      { return nil }
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.values)
+	return nil
+}
+
+func init() {
+	sql.Register("libtime-echo", echoDriver{})
+}
+
+var _ = Describe("SQL", func() {
+	var ctx context.Context
+	var db *sql.DB
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		db, err = sql.Open("libtime-echo", "")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		Expect(db.Close()).To(Succeed())
+	})
+	roundTrip := func(value interface{}, result interface{}) {
+		Expect(db.QueryRowContext(ctx, "SELECT ?", value).Scan(result)).To(Succeed())
+	}
+	Context("DateTime", func() {
+		It("round trips", func() {
+			var result libtime.DateTime
+			roundTrip(ParseDateTime("2024-03-05T14:07:09.123Z"), &result)
+			Expect(result.String()).To(Equal("2024-03-05T14:07:09.123Z"))
+		})
+		It("stores zero as NULL and scans NULL as zero", func() {
+			value, err := libtime.DateTime{}.Value()
+			Expect(err).To(BeNil())
+			Expect(value).To(BeNil())
+			result := ParseDateTime("2024-03-05T14:07:09Z")
+			roundTrip(nil, &result)
+			Expect(result.IsZero()).To(BeTrue())
+		})
+		It("stores nil pointer as NULL", func() {
+			var input *libtime.DateTime
+			var result sql.NullString
+			roundTrip(input, &result)
+			Expect(result.Valid).To(BeFalse())
+		})
+		DescribeTable("Scan",
+			func(src interface{}, expected string, expectError bool) {
+				var result libtime.DateTime
+				err := result.Scan(src)
+				if expectError {
+					Expect(err).NotTo(BeNil())
+					return
+				}
+				Expect(err).To(BeNil())
+				Expect(result.String()).To(Equal(expected))
+			},
+			Entry("rfc3339", "2024-03-05T14:07:09Z", "2024-03-05T14:07:09Z", false),
+			Entry("bytes", []byte("2024-03-05 14:07:09"), "2024-03-05T14:07:09Z", false),
+			Entry("postgres", "2024-03-05 14:07:09.5+01", "2024-03-05T14:07:09.5+01:00", false),
+			Entry("invalid", "banana", "", true),
+			Entry("unsupported", int64(1), "", true),
+		)
+	})
+	Context("Date", func() {
+		It("round trips", func() {
+			var result libtime.Date
+			roundTrip(ParseDate("2024-03-05"), &result)
+			Expect(result.String()).To(Equal("2024-03-05"))
+		})
+		It("scans DATE columns", func() {
+			var result libtime.Date
+			Expect(result.Scan([]byte("2024-03-05"))).To(Succeed())
+			Expect(result.String()).To(Equal("2024-03-05"))
+			Expect(result.Scan(time.Date(2024, 3, 5, 0, 0, 0, 0, time.Local))).To(Succeed())
+			Expect(result.String()).To(Equal("2024-03-05"))
+		})
+		It("scans NULL as zero", func() {
+			result := ParseDate("2024-03-05")
+			roundTrip(nil, &result)
+			Expect(result.Time().IsZero()).To(BeTrue())
+		})
+	})
+	Context("DateOrDateTime", func() {
+		It("round trips", func() {
+			var result libtime.DateOrDateTime
+			input := libtime.DateOrDateTime(time.Date(2024, 3, 5, 14, 7, 9, 0, time.UTC))
+			roundTrip(input, &result)
+			Expect(result.Time()).To(Equal(input.Time()))
+		})
+		It("stores zero as NULL", func() {
+			value, err := libtime.DateOrDateTime{}.Value()
+			Expect(err).To(BeNil())
+			Expect(value).To(BeNil())
+		})
+	})
+	Context("UnixTime", func() {
+		It("stores epoch seconds", func() {
+			value, err := libtime.UnixTimeFromSeconds(1687161394).Value()
+			Expect(err).To(BeNil())
+			Expect(value).To(Equal(int64(1687161394)))
+		})
+		It("round trips", func() {
+			var result libtime.UnixTime
+			roundTrip(libtime.UnixTimeFromSeconds(1687161394), &result)
+			Expect(result.Unix()).To(Equal(int64(1687161394)))
+		})
+		It("scans text and timestamp columns", func() {
+			var result libtime.UnixTime
+			Expect(result.Scan([]byte("1687161394"))).To(Succeed())
+			Expect(result.Unix()).To(Equal(int64(1687161394)))
+			Expect(result.Scan(time.Unix(1687161395, 0))).To(Succeed())
+			Expect(result.Unix()).To(Equal(int64(1687161395)))
+		})
+		It("stores zero as NULL and scans NULL as zero", func() {
+			value, err := libtime.UnixTime{}.Value()
+			Expect(err).To(BeNil())
+			Expect(value).To(BeNil())
+			result := libtime.UnixTimeFromSeconds(1687161394)
+			roundTrip(nil, &result)
+			Expect(result.IsZero()).To(BeTrue())
+		})
+		It("stores epoch milli, micro and nano", func() {
+			input := libtime.UnixTime(time.Unix(1687161394, 123456789))
+			milli, err := input.UnixMilliTime().Value()
+			Expect(err).To(BeNil())
+			Expect(milli).To(Equal(int64(1687161394123)))
+			micro, err := input.UnixMicroTime().Value()
+			Expect(err).To(BeNil())
+			Expect(micro).To(Equal(int64(1687161394123456)))
+			nano, err := input.UnixNanoTime().Value()
+			Expect(err).To(BeNil())
+			Expect(nano).To(Equal(int64(1687161394123456789)))
+			var result libtime.UnixMilliTime
+			roundTrip(input.UnixMilliTime(), &result)
+			Expect(result.UnixMilli()).To(Equal(int64(1687161394123)))
+		})
+	})
+	Context("TimeOfDay", func() {
+		It("round trips", func() {
+			var result libtime.TimeOfDay
+			roundTrip(libtime.TimeOfDay{Hour: 14, Minute: 7, Second: 9, Location: time.UTC}, &result)
+			Expect(result.String()).To(Equal("14:07:09Z"))
+		})
+		DescribeTable("Value",
+			func(input libtime.TimeOfDay, expected string) {
+				value, err := input.Value()
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal(expected))
+			},
+			Entry("zero", libtime.TimeOfDay{}, "00:00:00"),
+			Entry("time", libtime.TimeOfDay{Hour: 14, Minute: 7, Second: 9}, "14:07:09"),
+			Entry(
+				"fraction",
+				libtime.TimeOfDay{Hour: 14, Minute: 7, Second: 9, Nanosecond: 500000000},
+				"14:07:09.5",
+			),
+		)
+		It("scans TIME columns", func() {
+			var result libtime.TimeOfDay
+			Expect(result.Scan([]byte("14:07:09.25"))).To(Succeed())
+			Expect(result.Value()).To(Equal("14:07:09.25"))
+			Expect(result.Scan(time.Date(0, 1, 1, 8, 30, 0, 0, time.UTC))).To(Succeed())
+			Expect(result.Value()).To(Equal("08:30:00"))
+		})
+		It("scans NULL as zero", func() {
+			result := libtime.TimeOfDay{Hour: 14}
+			roundTrip(nil, &result)
+			Expect(result).To(Equal(libtime.TimeOfDay{}))
+		})
+	})
+	Context("Duration", func() {
+		It("stores nanoseconds", func() {
+			value, err := (90 * libtime.Minute).Value()
+			Expect(err).To(BeNil())
+			Expect(value).To(Equal(int64(90 * time.Minute)))
+		})
+		It("round trips", func() {
+			var result libtime.Duration
+			roundTrip(90*libtime.Minute, &result)
+			Expect(result).To(Equal(90 * libtime.Minute))
+		})
+		It("scans NULL as zero", func() {
+			result := libtime.Hour
+			roundTrip(nil, &result)
+			Expect(result).To(Equal(libtime.Duration(0)))
+		})
+		DescribeTable("Scan",
+			func(src interface{}, expected libtime.Duration, expectError bool) {
+				var result libtime.Duration
+				err := result.Scan(src)
+				if expectError {
+					Expect(err).NotTo(BeNil())
+					return
+				}
+				Expect(err).To(BeNil())
+				Expect(result).To(Equal(expected))
+			},
+			Entry("bigint", int64(1500), libtime.Duration(1500), false),
+			Entry("bigint text", []byte("1500"), libtime.Duration(1500), false),
+			Entry("go duration", "1h30m", 90*libtime.Minute, false),
+			Entry("interval", "01:30:00", 90*libtime.Minute, false),
+			Entry("interval fraction", "00:00:01.000001", libtime.Second+libtime.Microsecond, false),
+			Entry("interval days", []byte("2 days 01:00:00"), 2*libtime.Day+libtime.Hour, false),
+			Entry("interval negative", "-01:30:00", -90*libtime.Minute, false),
+			Entry("interval day only", "1 day", libtime.Day, false),
+			Entry("interval months", "1 mon 2 days", libtime.Duration(0), true),
+			Entry("interval years", "1 year", libtime.Duration(0), true),
+			Entry("invalid", "banana", libtime.Duration(0), true),
+			Entry("unsupported", 1.5, libtime.Duration(0), true),
+		)
+		DescribeTable("SQLInterval",
+			func(input libtime.Duration, expected string) {
+				Expect(input.SQLInterval()).To(Equal(expected))
+				var result libtime.Duration
+				Expect(result.Scan(expected)).To(Succeed())
+				Expect(result).To(Equal(input))
+			},
+			Entry("zero", libtime.Duration(0), "00:00:00"),
+			Entry("time", 90*libtime.Minute, "01:30:00"),
+			Entry("fraction", libtime.Second+libtime.Millisecond, "00:00:01.001"),
+			Entry("one day", libtime.Day+libtime.Hour, "1 day 01:00:00"),
+			Entry("days", 3*libtime.Day, "3 days 00:00:00"),
+			Entry("negative", -(libtime.Day+libtime.Hour), "-1 day -01:00:00"),
+			Entry(
+				"minimum",
+				libtime.Duration(math.MinInt64),
+				"-106751 days -23:47:16.854775808",
+			),
+			Entry(
+				"maximum",
+				libtime.Duration(math.MaxInt64),
+				"106751 days 23:47:16.854775807",
+			),
+		)
+	})
+})
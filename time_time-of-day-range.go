@@ -0,0 +1,210 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"strings"
+	stdtime "time"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/parse"
+	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
+)
+
+// ParseTimeOfDayRange parses a daily window like "22:00-02:00 Europe/Berlin".
+// Times accept the layouts of ParseTimeOfDay without offset, the location defaults to UTC.
+func ParseTimeOfDayRange(ctx context.Context, value interface{}) (*TimeOfDayRange, error) {
+	str, err := parse.ParseString(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse value failed")
+	}
+	location := stdtime.UTC
+	rangeStr, locationStr, hasLocation := strings.Cut(strings.TrimSpace(str), " ")
+	if hasLocation {
+		location, err = LoadLocation(ctx, strings.TrimSpace(locationStr))
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "load location '%s' failed", locationStr)
+		}
+	}
+	fromStr, untilStr, ok := strings.Cut(rangeStr, "-")
+	if !ok {
+		return nil, errors.Wrapf(ctx, validation.Error, "time of day range '%s' has no '-'", str)
+	}
+	from, err := ParseTimeOfDay(ctx, fromStr)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse from '%s' failed", fromStr)
+	}
+	until, err := ParseTimeOfDay(ctx, untilStr)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse until '%s' failed", untilStr)
+	}
+	from.Location = location
+	until.Location = location
+	return &TimeOfDayRange{From: *from, Until: *until}, nil
+}
+
+// TimeOfDayRange is a daily window from From up to but excluding Until.
+// If Until is not after From the window crosses midnight, like 22:00-02:00,
+// and equal times cover the whole day. The window uses the Location of From.
+type TimeOfDayRange struct {
+	From  TimeOfDay
+	Until TimeOfDay
+}
+
+var _ encoding.TextMarshaler = TimeOfDayRange{}
+
+var _ encoding.TextUnmarshaler = (*TimeOfDayRange)(nil)
+
+var _ yaml.Marshaler = TimeOfDayRange{}
+
+var _ yaml.Unmarshaler = (*TimeOfDayRange)(nil)
+
+func (r TimeOfDayRange) Validate(ctx context.Context) error {
+	return validation.All{
+		validation.Name("from", r.From),
+		validation.Name("until", r.Until),
+		validation.Name("location", validation.HasValidationFunc(func(ctx context.Context) error {
+			if r.From.Location.String() != r.Until.Location.String() {
+				return errors.Wrapf(
+					ctx,
+					validation.Error,
+					"from and until must have the same location",
+				)
+			}
+			return nil
+		})),
+	}.Validate(ctx)
+}
+
+func (r TimeOfDayRange) Ptr() *TimeOfDayRange {
+	return &r
+}
+
+// String returns the window like "22:00:00-02:00:00 Europe/Berlin".
+func (r TimeOfDayRange) String() string {
+	result := r.From.clock() + "-" + r.Until.clock()
+	if r.From.Location != nil {
+		result += " " + r.From.Location.String()
+	}
+	return result
+}
+
+// CrossesMidnight reports whether the window ends on the next day.
+func (r TimeOfDayRange) CrossesMidnight() bool {
+	return r.Until.Compare(r.From) <= 0
+}
+
+// Duration returns the wall clock length of the window. Occurrences on days with
+// daylight saving transitions can be shorter or longer.
+func (r TimeOfDayRange) Duration() Duration {
+	result := r.Until.Sub(r.From)
+	if result <= 0 {
+		result += Day
+	}
+	return result
+}
+
+// Contains reports whether the window contains dateTime.
+func (r TimeOfDayRange) Contains(dateTime DateTime) bool {
+	date := r.localDate(dateTime)
+	for _, occurrence := range []DateTimeRange{r.On(date.AddDate(0, 0, -1)), r.On(date)} {
+		if !dateTime.Before(occurrence.From) && !dateTime.After(occurrence.Until) {
+			return true
+		}
+	}
+	return false
+}
+
+// On returns the occurrence starting on date with inclusive Until like DateTimeRange.
+func (r TimeOfDayRange) On(date Date) DateTimeRange {
+	untilDate := date
+	if r.CrossesMidnight() {
+		untilDate = date.AddDate(0, 0, 1)
+	}
+	until := r.Until
+	until.Location = r.From.Location
+	return DateTimeRange{
+		From:  r.From.On(date),
+		Until: until.On(untilDate).Add(-Nanosecond),
+	}
+}
+
+// Occurrences returns all complete occurrences overlapping dateTimeRange in order.
+func (r TimeOfDayRange) Occurrences(dateTimeRange DateTimeRange) DateTimeRanges {
+	result := DateTimeRanges{}
+	if dateTimeRange.From.After(dateTimeRange.Until) {
+		return result
+	}
+	date := r.localDate(dateTimeRange.From).AddDate(0, 0, -1)
+	last := r.localDate(dateTimeRange.Until)
+	for ; !date.After(last); date = date.AddDate(0, 0, 1) {
+		occurrence := r.On(date)
+		if occurrence.From.After(dateTimeRange.Until) ||
+			occurrence.Until.Before(dateTimeRange.From) {
+			continue
+		}
+		result = append(result, occurrence)
+	}
+	return result
+}
+
+// localDate returns the date of dateTime in the location of the window.
+func (r TimeOfDayRange) localDate(dateTime DateTime) Date {
+	year, month, day := dateTime.Time().In(locationOrUTC(r.From.Location)).Date()
+	return Date(stdtime.Date(year, month, day, 0, 0, 0, 0, stdtime.UTC))
+}
+
+// MarshalText writes the range like ParseTimeOfDayRange reads it.
+// The zero TimeOfDayRange is written as empty text, so it does not turn into a whole day.
+func (r TimeOfDayRange) MarshalText() ([]byte, error) {
+	if r == (TimeOfDayRange{}) {
+		return nil, nil
+	}
+	return []byte(r.String()), nil
+}
+
+func (r *TimeOfDayRange) UnmarshalText(b []byte) error {
+	str := string(b)
+	if len(str) == 0 {
+		*r = TimeOfDayRange{}
+		return nil
+	}
+	parsed, err := ParseTimeOfDayRange(context.Background(), str)
+	if err != nil {
+		return errors.Wrapf(context.Background(), err, "parse time of day range failed")
+	}
+	*r = *parsed
+	return nil
+}
+
+// MarshalJSON writes the range like MarshalText. The zero TimeOfDayRange is written as null.
+func (r TimeOfDayRange) MarshalJSON() ([]byte, error) {
+	if r == (TimeOfDayRange{}) {
+		return []byte("null"), nil
+	}
+	return json.Marshal(r.String())
+}
+
+func (r *TimeOfDayRange) UnmarshalJSON(b []byte) error {
+	str := strings.Trim(string(b), `"`)
+	if str == "null" {
+		str = ""
+	}
+	return r.UnmarshalText([]byte(str))
+}
+
+// MarshalYAML implements yaml.Marshaler like MarshalText.
+func (r TimeOfDayRange) MarshalYAML() (interface{}, error) {
+	return marshalYAMLText(r)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. Empty values and "null" result in the zero TimeOfDayRange.
+func (r *TimeOfDayRange) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLText(node, r)
+}
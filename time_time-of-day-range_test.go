@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("TimeOfDayRange", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	parseRange := func(value string) libtime.TimeOfDayRange {
+		result, err := libtime.ParseTimeOfDayRange(ctx, value)
+		Expect(err).To(BeNil())
+		return *result
+	}
+	DescribeTable("ParseTimeOfDayRange",
+		func(input string, expected string, expectError bool) {
+			result, err := libtime.ParseTimeOfDayRange(ctx, input)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(result.String()).To(Equal(expected))
+			Expect(result.Validate(ctx)).To(BeNil())
+		},
+		Entry("utc", "08:00-17:30", "08:00:00-17:30:00 UTC", false),
+		Entry("location", "22:00-02:00 Europe/Berlin", "22:00:00-02:00:00 Europe/Berlin", false),
+		Entry("seconds", "08:00:15-08:00:45.5", "08:00:15-08:00:45.5 UTC", false),
+		Entry("missing dash", "08:00", "", true),
+		Entry("invalid time", "08:00-banana", "", true),
+		Entry("invalid location", "08:00-09:00 Mars/Olympus", "", true),
+	)
+	DescribeTable("Duration",
+		func(input string, expected libtime.Duration) {
+			Expect(parseRange(input).Duration()).To(Equal(expected))
+		},
+		Entry("same day", "08:00-17:30", 9*libtime.Hour+30*libtime.Minute),
+		Entry("crosses midnight", "22:00-02:00", 4*libtime.Hour),
+		Entry("whole day", "06:00-06:00", libtime.Day),
+	)
+	DescribeTable("Contains",
+		func(input string, dateTime string, expected bool) {
+			Expect(parseRange(input).Contains(ParseDateTime(dateTime))).To(Equal(expected))
+		},
+		Entry("inside", "08:00-17:00", "2024-03-05T12:00:00Z", true),
+		Entry("start inclusive", "08:00-17:00", "2024-03-05T08:00:00Z", true),
+		Entry("end exclusive", "08:00-17:00", "2024-03-05T17:00:00Z", false),
+		Entry("before", "08:00-17:00", "2024-03-05T07:59:59Z", false),
+		Entry("late evening", "22:00-02:00 Europe/Berlin", "2024-03-05T22:30:00Z", true),
+		Entry("after midnight", "22:00-02:00 Europe/Berlin", "2024-03-05T00:30:00Z", true),
+		Entry("after window", "22:00-02:00 Europe/Berlin", "2024-03-05T01:30:00Z", false),
+		Entry("evening before window", "22:00-02:00 Europe/Berlin", "2024-03-05T20:30:00Z", false),
+		Entry("whole day", "06:00-06:00", "2024-03-05T05:59:00Z", true),
+	)
+	It("returns occurrences crossing midnight", func() {
+		window := parseRange("22:00-02:00 Europe/Berlin")
+		result := window.Occurrences(libtime.DateTimeRange{
+			From:  ParseDateTime("2024-03-30T12:00:00Z"),
+			Until: ParseDateTime("2024-03-31T23:00:00Z"),
+		})
+		Expect(result).To(HaveLen(2))
+		Expect(result[0].From.String()).To(Equal("2024-03-30T22:00:00+01:00"))
+		Expect(result[0].Until.String()).To(Equal("2024-03-31T01:59:59.999999999+01:00"))
+		Expect(result[1].From.String()).To(Equal("2024-03-31T22:00:00+02:00"))
+		Expect(result[1].Until.String()).To(Equal("2024-04-01T01:59:59.999999999+02:00"))
+		// the first occurrence ends at the nonexistent 02:00, which shifts forward to 03:00 CEST
+		Expect(result[0].Until.Sub(result[0].From) + libtime.Nanosecond).To(Equal(4 * libtime.Hour))
+	})
+	It("includes occurrences started before the range", func() {
+		window := parseRange("22:00-02:00")
+		result := window.Occurrences(libtime.DateTimeRange{
+			From:  ParseDateTime("2024-03-05T01:00:00Z"),
+			Until: ParseDateTime("2024-03-05T03:00:00Z"),
+		})
+		Expect(result).To(HaveLen(1))
+		Expect(result[0].From.String()).To(Equal("2024-03-04T22:00:00Z"))
+	})
+	It("returns no occurrences for inverted ranges", func() {
+		result := parseRange("08:00-09:00").Occurrences(libtime.DateTimeRange{
+			From:  ParseDateTime("2024-03-06T00:00:00Z"),
+			Until: ParseDateTime("2024-03-05T00:00:00Z"),
+		})
+		Expect(result).To(BeEmpty())
+	})
+	It("validates", func() {
+		Expect(parseRange("08:00-09:00").Validate(ctx)).To(BeNil())
+		invalid := parseRange("08:00-09:00")
+		invalid.Until.Hour = 24
+		Expect(invalid.Validate(ctx)).NotTo(BeNil())
+		mixed := parseRange("08:00-09:00")
+		mixed.Until = ParseTimeOfDay("09:00 Europe/Berlin")
+		Expect(mixed.Validate(ctx)).NotTo(BeNil())
+	})
+	Context("marshaling", func() {
+		type TestStruct struct {
+			Window libtime.TimeOfDayRange `json:"window" yaml:"window"`
+		}
+		It("round trips JSON", func() {
+			bytes, err := json.Marshal(TestStruct{Window: parseRange("22:00-02:00 Europe/Berlin")})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal(`{"window":"22:00:00-02:00:00 Europe/Berlin"}`))
+			var result TestStruct
+			Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result.Window.String()).To(Equal("22:00:00-02:00:00 Europe/Berlin"))
+		})
+		It("round trips YAML", func() {
+			bytes, err := yaml.Marshal(TestStruct{Window: parseRange("08:00-17:00")})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(MatchRegexp(`^window: "?08:00:00-17:00:00 UTC"?\n$`))
+			var result TestStruct
+			Expect(yaml.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result.Window.String()).To(Equal("08:00:00-17:00:00 UTC"))
+		})
+		It("round trips zero as null", func() {
+			bytes, err := json.Marshal(TestStruct{})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal(`{"window":null}`))
+			result := TestStruct{Window: parseRange("08:00-17:00")}
+			Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result.Window).To(Equal(libtime.TimeOfDayRange{}))
+
+			bytes, err = yaml.Marshal(TestStruct{})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal("window: null\n"))
+			result = TestStruct{}
+			Expect(yaml.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result.Window).To(Equal(libtime.TimeOfDayRange{}))
+
+			text, err := libtime.TimeOfDayRange{}.MarshalText()
+			Expect(err).To(BeNil())
+			Expect(text).To(BeEmpty())
+		})
+		It("keeps a whole day range", func() {
+			bytes, err := json.Marshal(TestStruct{Window: parseRange("00:00-00:00")})
+			Expect(err).To(BeNil())
+			var result TestStruct
+			Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result.Window.Duration()).To(Equal(libtime.Day))
+		})
+		It("fails on invalid JSON values", func() {
+			var result TestStruct
+			Expect(json.Unmarshal([]byte(`{"window":"banana"}`), &result)).NotTo(Succeed())
+		})
+	})
+})
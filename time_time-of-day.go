@@ -6,13 +6,18 @@ package time
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"encoding"
 	"encoding/json"
+	"fmt"
 	"strings"
 	stdtime "time"
 
 	"github.com/bborbe/errors"
 	"github.com/bborbe/parse"
+	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
 )
 
 const TimeOfDayLayout = "15:04:05.999999999Z07:00"
@@ -70,24 +75,24 @@ func ParseTimeOfDay(ctx context.Context, value interface{}) (*TimeOfDay, error)
 		return timeOfDay, nil
 	}
 
-	var t stdtime.Time
-	for _, layout := range []string{
-		"15:04:05.999999999Z07:00",
-		"15:04:05.999999999",
-		"15:04:05Z07:00",
-		"15:04:05",
-		"15:04Z07:00",
-		"15:04",
-		stdtime.RFC3339Nano,
-		stdtime.RFC3339,
-		stdtime.DateTime,
-	} {
-		t, err = stdtime.Parse(layout, str)
-		if err == nil {
-			return TimeOfDayFromTime(t.In(stdtime.UTC)).Ptr(), nil
-		}
+	t, err := parseLayouts(str, parseTimeOfDayLayouts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse timeOfDay failed")
 	}
-	return nil, errors.Wrapf(ctx, err, "parse timeOfDay failed")
+	return TimeOfDayFromTime(t.In(stdtime.UTC)).Ptr(), nil
+}
+
+// parseTimeOfDayLayouts are the layouts ParseTimeOfDay tries in order.
+var parseTimeOfDayLayouts = []string{
+	"15:04:05.999999999Z07:00",
+	"15:04:05.999999999",
+	"15:04:05Z07:00",
+	"15:04:05",
+	"15:04Z07:00",
+	"15:04",
+	stdtime.RFC3339Nano,
+	stdtime.RFC3339,
+	stdtime.DateTime,
 }
 
 func TimeOfDayFromTime(date stdtime.Time) TimeOfDay {
@@ -112,6 +117,36 @@ var _ encoding.TextMarshaler = TimeOfDay{}
 
 var _ encoding.TextUnmarshaler = (*TimeOfDay)(nil)
 
+var _ sql.Scanner = (*TimeOfDay)(nil)
+
+var _ driver.Valuer = TimeOfDay{}
+
+var _ yaml.Marshaler = TimeOfDay{}
+
+var _ yaml.Unmarshaler = (*TimeOfDay)(nil)
+
+// Validate checks the clock fields. A nil Location is valid and treated as UTC.
+func (t TimeOfDay) Validate(ctx context.Context) error {
+	if t.Hour < 0 || t.Hour > 23 {
+		return errors.Wrapf(ctx, validation.Error, "hour %d must be between 0 and 23", t.Hour)
+	}
+	if t.Minute < 0 || t.Minute > 59 {
+		return errors.Wrapf(ctx, validation.Error, "minute %d must be between 0 and 59", t.Minute)
+	}
+	if t.Second < 0 || t.Second > 59 {
+		return errors.Wrapf(ctx, validation.Error, "second %d must be between 0 and 59", t.Second)
+	}
+	if t.Nanosecond < 0 || t.Nanosecond > 999999999 {
+		return errors.Wrapf(
+			ctx,
+			validation.Error,
+			"nanosecond %d must be between 0 and 999999999",
+			t.Nanosecond,
+		)
+	}
+	return nil
+}
+
 func (t TimeOfDay) String() string {
 	return t.Format(TimeOfDayLayout)
 }
@@ -124,6 +159,8 @@ func (t TimeOfDay) DateTime(year int, month stdtime.Month, day int) DateTime {
 	return DateTime(t.date(year, month, day))
 }
 
+// Time returns t on the given day. Ambiguous and nonexistent local times are resolved
+// like time.Date, use TimeWithResolver to control it.
 func (t TimeOfDay) Time(year int, month stdtime.Month, day int) stdtime.Time {
 	return t.date(year, month, day)
 }
@@ -133,25 +170,54 @@ func (t TimeOfDay) Date(year int, month stdtime.Month, day int) (*stdtime.Time,
 	return &time, nil
 }
 
+// TimeWithResolver returns t on the given day, resolving ambiguous and nonexistent
+// local times with resolver. A nil Location is treated as UTC.
+func (t TimeOfDay) TimeWithResolver(
+	ctx context.Context,
+	year int,
+	month stdtime.Month,
+	day int,
+	resolver LocalTimeResolver,
+) (*stdtime.Time, error) {
+	wall := stdtime.Date(year, month, day, t.Hour, t.Minute, t.Second, t.Nanosecond, stdtime.UTC)
+	result, err := newLocalTime(wall, t.Location).resolve(ctx, resolver)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "resolve %s on %s failed", t, wall.Format(stdtime.DateOnly))
+	}
+	return &result, nil
+}
+
+// date returns t on the given day. A nil Location is treated as UTC.
 func (t TimeOfDay) date(year int, month stdtime.Month, day int) stdtime.Time {
-	return stdtime.Date(year, month, day, t.Hour, t.Minute, t.Second, t.Nanosecond, t.Location)
+	location := t.Location
+	if location == nil {
+		location = stdtime.UTC
+	}
+	return stdtime.Date(year, month, day, t.Hour, t.Minute, t.Second, t.Nanosecond, location)
 }
 
+// UnmarshalJSON reads times of day like UnmarshalText. null results in the zero TimeOfDay.
 func (t *TimeOfDay) UnmarshalJSON(b []byte) error {
 	str := strings.Trim(string(b), `"`)
-	parse, err := ParseTimeOfDay(context.Background(), str)
-	if err != nil {
-		return errors.Wrapf(context.Background(), err, "parse day of time failed")
+	if str == "null" {
+		str = ""
 	}
-	*t = *parse
-	return nil
+	return t.UnmarshalText([]byte(str))
 }
 
+// MarshalJSON writes t like MarshalText. The zero TimeOfDay is written as null.
 func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	if t == (TimeOfDay{}) {
+		return []byte("null"), nil
+	}
 	return json.Marshal(t.String())
 }
 
+// MarshalText implements encoding.TextMarshaler. The zero TimeOfDay is written as empty text.
 func (t TimeOfDay) MarshalText() ([]byte, error) {
+	if t == (TimeOfDay{}) {
+		return nil, nil
+	}
 	return []byte(t.String()), nil
 }
 
@@ -169,18 +235,198 @@ func (t *TimeOfDay) UnmarshalText(b []byte) error {
 	return nil
 }
 
+// MarshalYAML implements yaml.Marshaler. The zero TimeOfDay is written as null like in MarshalText.
+func (t TimeOfDay) MarshalYAML() (interface{}, error) {
+	return marshalYAMLText(t)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. Empty values and "null" result in the zero TimeOfDay.
+func (t *TimeOfDay) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLText(node, t)
+}
+
+// Scan implements sql.Scanner for TIME columns. NULL scans into the zero TimeOfDay.
+func (t *TimeOfDay) Scan(src interface{}) error {
+	ctx := context.Background()
+	switch v := src.(type) {
+	case nil:
+		*t = TimeOfDay{}
+		return nil
+	case stdtime.Time:
+		*t = TimeOfDayFromTime(v)
+		return nil
+	case []byte:
+		return t.Scan(string(v))
+	case string:
+		parsed, err := ParseTimeOfDay(ctx, v)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "scan time of day failed")
+		}
+		*t = *parsed
+		return nil
+	default:
+		return errors.Errorf(ctx, "can not scan %T into time of day", src)
+	}
+}
+
+// Value implements driver.Valuer and returns the wall clock like "15:04:05.5" for TIME columns.
+// The location is not stored.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	return t.clock(), nil
+}
+
+// clock returns the wall clock like "15:04:05.5" without location.
+func (t TimeOfDay) clock() string {
+	result := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+	if t.Nanosecond > 0 {
+		result += strings.TrimRight(fmt.Sprintf(".%09d", t.Nanosecond), "0")
+	}
+	return result
+}
+
+// WithLocation returns t with the same wall clock in location, for example a Location.
+func (t TimeOfDay) WithLocation(location HasLocation) TimeOfDay {
+	t.Location = location.Location()
+	return t
+}
+
 func (t TimeOfDay) Ptr() *TimeOfDay {
 	return &t
 }
 
+// On returns t on date in the zone rules of t.Location that apply on that date.
+// Ambiguous times resolve to the earlier instant and nonexistent times shift forward
+// by the daylight saving gap. A nil Location is treated as UTC.
+func (t TimeOfDay) On(date Date) DateTime {
+	result, _ := t.OnWithResolver(context.Background(), date, LocalTimeResolverShiftForward)
+	return *result
+}
+
+// OnWithResolver returns t on date like On, resolving ambiguous and nonexistent times
+// with resolver.
+func (t TimeOfDay) OnWithResolver(
+	ctx context.Context,
+	date Date,
+	resolver LocalTimeResolver,
+) (*DateTime, error) {
+	result, err := t.TimeWithResolver(ctx, date.Year(), date.Month(), date.Day(), resolver)
+	if err != nil {
+		return nil, err
+	}
+	return DateTime(*result).Ptr(), nil
+}
+
+// CompareOn compares the instants of t and other on date and returns -1, 0 or +1.
+// Both are resolved with On, so different locations and daylight saving time are respected.
+func (t TimeOfDay) CompareOn(date Date, other TimeOfDay) int {
+	return t.On(date).Compare(other.On(date))
+}
+
+// BeforeOn reports whether t is before other on date.
+func (t TimeOfDay) BeforeOn(date Date, other TimeOfDay) bool {
+	return t.CompareOn(date, other) < 0
+}
+
+// AfterOn reports whether t is after other on date.
+func (t TimeOfDay) AfterOn(date Date, other TimeOfDay) bool {
+	return t.CompareOn(date, other) > 0
+}
+
+// EqualOn reports whether t and other are the same instant on date.
+func (t TimeOfDay) EqualOn(date Date, other TimeOfDay) bool {
+	return t.CompareOn(date, other) == 0
+}
+
+// Before compares the instants on 2024-07-01. Use BeforeOn to compare on a specific date.
 func (t TimeOfDay) Before(stdTime TimeOfDay) bool {
 	return t.Time(2024, 07, 01).Before(stdTime.Time(2024, 07, 01))
 }
 
+// After compares the instants on 2024-07-01. Use AfterOn to compare on a specific date.
 func (t TimeOfDay) After(stdTime TimeOfDay) bool {
 	return t.Time(2024, 07, 01).After(stdTime.Time(2024, 07, 01))
 }
 
+// Equal compares the instants on 2024-07-01. Use EqualOn to compare on a specific date.
 func (t TimeOfDay) Equal(stdTime TimeOfDay) bool {
 	return t.Time(2024, 07, 01).Equal(stdTime.Time(2024, 07, 01))
 }
+
+// Compare compares the wall clock of t and other and returns -1, 0 or +1.
+// Unlike Before, After and Equal it ignores the Location and needs no date.
+func (t TimeOfDay) Compare(other TimeOfDay) int {
+	a, b := t.sinceMidnight(), other.sinceMidnight()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// SecondsSinceMidnight returns the wall clock in whole seconds since midnight.
+func (t TimeOfDay) SecondsSinceMidnight() int {
+	return t.Hour*3600 + t.Minute*60 + t.Second
+}
+
+// Add adds duration to the wall clock and wraps around midnight. It returns the new
+// TimeOfDay and the number of days carried, which is negative for negative durations.
+// Daylight saving time is ignored, use Time to add durations to instants.
+func (t TimeOfDay) Add(duration HasDuration) (TimeOfDay, int) {
+	total := t.sinceMidnight() + duration.Duration()
+	days := total / stdtime.Duration(Day)
+	if total%stdtime.Duration(Day) < 0 {
+		days--
+	}
+	return timeOfDayFromDuration(total-days*stdtime.Duration(Day), t.Location), int(days)
+}
+
+// Sub returns the wall clock duration t-other, ignoring the Location.
+func (t TimeOfDay) Sub(other TimeOfDay) Duration {
+	return Duration(t.sinceMidnight() - other.sinceMidnight())
+}
+
+// Truncate rounds t down to a multiple of duration since midnight.
+// Durations <= 0 return t unchanged.
+func (t TimeOfDay) Truncate(duration HasDuration) TimeOfDay {
+	d := duration.Duration()
+	if d <= 0 {
+		return t
+	}
+	since := t.sinceMidnight()
+	return timeOfDayFromDuration(since-since%d, t.Location)
+}
+
+// Round rounds t to the nearest multiple of duration since midnight, halfway values
+// round up. Like Add it wraps around midnight and returns the number of days carried,
+// so 23:59:45 rounded to minutes is 00:00 and 1. Durations <= 0 return t unchanged.
+func (t TimeOfDay) Round(duration HasDuration) (TimeOfDay, int) {
+	d := duration.Duration()
+	if d <= 0 {
+		return t, 0
+	}
+	since := t.sinceMidnight()
+	rounded := since - since%d
+	if since%d*2 >= d {
+		rounded += d
+	}
+	days := rounded / stdtime.Duration(Day)
+	return timeOfDayFromDuration(rounded%stdtime.Duration(Day), t.Location), int(days)
+}
+
+func (t TimeOfDay) sinceMidnight() stdtime.Duration {
+	return stdtime.Duration(t.SecondsSinceMidnight())*stdtime.Second +
+		stdtime.Duration(t.Nanosecond)
+}
+
+func timeOfDayFromDuration(duration stdtime.Duration, location *stdtime.Location) TimeOfDay {
+	return TimeOfDay{
+		Hour:       int(duration / stdtime.Hour),
+		Minute:     int(duration % stdtime.Hour / stdtime.Minute),
+		Second:     int(duration % stdtime.Minute / stdtime.Second),
+		Nanosecond: int(duration % stdtime.Second),
+		Location:   location,
+	}
+}
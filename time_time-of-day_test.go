@@ -197,6 +197,40 @@ var _ = Describe("TimeOfDay", func() {
 			false,
 		),
 	)
+	It("unmarshals JSON null as zero", func() {
+		timeOfDay = ParseTimeOfDay("13:37")
+		Expect(timeOfDay.UnmarshalJSON([]byte(`null`))).To(Succeed())
+		Expect(timeOfDay).To(Equal(libtime.TimeOfDay{}))
+	})
+	DescribeTable("writes the same value to JSON and YAML",
+		func(input libtime.TimeOfDay, expectedJSON string, expectedYAML string) {
+			type TestStruct struct {
+				Value libtime.TimeOfDay `json:"value" yaml:"value"`
+			}
+			jsonBytes, err := json.Marshal(TestStruct{Value: input})
+			Expect(err).To(BeNil())
+			Expect(string(jsonBytes)).To(Equal(`{"value":` + expectedJSON + `}`))
+			yamlBytes, err := yaml.Marshal(TestStruct{Value: input})
+			Expect(err).To(BeNil())
+			Expect(string(yamlBytes)).To(Equal("value: " + expectedYAML + "\n"))
+
+			var fromJSON, fromYAML TestStruct
+			Expect(json.Unmarshal(jsonBytes, &fromJSON)).To(Succeed())
+			Expect(yaml.Unmarshal(yamlBytes, &fromYAML)).To(Succeed())
+			Expect(fromJSON.Value.String()).To(Equal(input.String()))
+			Expect(fromYAML.Value.String()).To(Equal(input.String()))
+			Expect(fromJSON.Value == libtime.TimeOfDay{}).To(Equal(input == libtime.TimeOfDay{}))
+			Expect(fromYAML.Value == libtime.TimeOfDay{}).To(Equal(input == libtime.TimeOfDay{}))
+		},
+		Entry("zero", libtime.TimeOfDay{}, `null`, `null`),
+		Entry("midnight", libtime.TimeOfDay{Location: time.UTC}, `"00:00:00Z"`, `00:00:00Z`),
+		Entry(
+			"time",
+			libtime.TimeOfDay{Hour: 13, Minute: 37, Location: time.UTC},
+			`"13:37:00Z"`,
+			`13:37:00Z`,
+		),
+	)
 	Context("YAML round-trip - Phase 2", func() {
 		type TestStruct struct {
 			TimeOfDay    libtime.TimeOfDay  `yaml:"timeOfDay"`
@@ -401,4 +435,205 @@ var _ = Describe("TimeOfDay", func() {
 			)
 		})
 	})
+	DescribeTable("Validate",
+		func(input libtime.TimeOfDay, expectError bool) {
+			if expectError {
+				Expect(input.Validate(ctx)).NotTo(BeNil())
+			} else {
+				Expect(input.Validate(ctx)).To(BeNil())
+			}
+		},
+		Entry("valid", libtime.TimeOfDay{Hour: 23, Minute: 59, Second: 59, Location: time.UTC}, false),
+		Entry("midnight", libtime.TimeOfDay{Location: time.UTC}, false),
+		Entry("hour", libtime.TimeOfDay{Hour: 25, Location: time.UTC}, true),
+		Entry("negative hour", libtime.TimeOfDay{Hour: -1, Location: time.UTC}, true),
+		Entry("minute", libtime.TimeOfDay{Minute: 60, Location: time.UTC}, true),
+		Entry("second", libtime.TimeOfDay{Second: 60, Location: time.UTC}, true),
+		Entry("nanosecond", libtime.TimeOfDay{Nanosecond: 1e9, Location: time.UTC}, true),
+		Entry("nil location is UTC", libtime.TimeOfDay{Hour: 12}, false),
+	)
+	DescribeTable("Add",
+		func(input string, duration libtime.Duration, expected string, expectedDays int) {
+			result, days := ParseTimeOfDay(input).Add(duration)
+			Expect(result.String()).To(Equal(expected))
+			Expect(days).To(Equal(expectedDays))
+		},
+		Entry("same day", "13:37", 2*libtime.Hour, "15:37:00Z", 0),
+		Entry("next day", "23:30", libtime.Hour, "00:30:00Z", 1),
+		Entry("exactly midnight", "23:00", libtime.Hour, "00:00:00Z", 1),
+		Entry("several days", "12:00", 50*libtime.Hour, "14:00:00Z", 2),
+		Entry("previous day", "00:30", -libtime.Hour, "23:30:00Z", -1),
+		Entry("negative same day", "12:00", -libtime.Hour, "11:00:00Z", 0),
+		Entry("negative whole day", "12:00", -libtime.Day, "12:00:00Z", -1),
+		Entry("nanoseconds", "23:59:59.999999999", libtime.Nanosecond, "00:00:00Z", 1),
+	)
+	It("keeps the location on Add", func() {
+		result, _ := ParseTimeOfDay("13:37 Europe/Berlin").Add(libtime.Hour)
+		Expect(result.Location.String()).To(Equal("Europe/Berlin"))
+		Expect(result.Hour).To(Equal(14))
+	})
+	DescribeTable("Sub",
+		func(a string, b string, expected libtime.Duration) {
+			Expect(ParseTimeOfDay(a).Sub(ParseTimeOfDay(b))).To(Equal(expected))
+		},
+		Entry("positive", "15:30", "13:00", 150*libtime.Minute),
+		Entry("negative", "08:00", "09:00:01", -libtime.Hour-libtime.Second),
+		Entry("equal", "08:00", "08:00", libtime.Duration(0)),
+	)
+	DescribeTable("Truncate and Round",
+		func(
+			input string,
+			duration libtime.Duration,
+			expectedTruncate string,
+			expectedRound string,
+			expectedDays int,
+		) {
+			Expect(ParseTimeOfDay(input).Truncate(duration).String()).To(Equal(expectedTruncate))
+			result, days := ParseTimeOfDay(input).Round(duration)
+			Expect(result.String()).To(Equal(expectedRound))
+			Expect(days).To(Equal(expectedDays))
+		},
+		Entry("minute", "13:37:29", libtime.Minute, "13:37:00Z", "13:37:00Z", 0),
+		Entry("minute half", "13:37:30", libtime.Minute, "13:37:00Z", "13:38:00Z", 0),
+		Entry("quarter", "13:38", 15*libtime.Minute, "13:30:00Z", "13:45:00Z", 0),
+		Entry("carries at midnight", "23:59:59", libtime.Minute, "23:59:00Z", "00:00:00Z", 1),
+		Entry("stays before midnight", "23:59:29", libtime.Minute, "23:59:00Z", "23:59:00Z", 0),
+		Entry("longer than a day", "20:00", 30*libtime.Hour, "00:00:00Z", "06:00:00Z", 1),
+		Entry("fraction", "13:37:00.6", libtime.Second, "13:37:00Z", "13:37:01Z", 0),
+		Entry("zero duration", "13:37:00.6", libtime.Duration(0), "13:37:00.6Z", "13:37:00.6Z", 0),
+	)
+	DescribeTable("Compare",
+		func(a libtime.TimeOfDay, b libtime.TimeOfDay, expected int) {
+			Expect(a.Compare(b)).To(Equal(expected))
+		},
+		Entry("less", ParseTimeOfDay("08:00"), ParseTimeOfDay("09:00"), -1),
+		Entry("greater", ParseTimeOfDay("08:00:00.1"), ParseTimeOfDay("08:00"), 1),
+		Entry("equal", ParseTimeOfDay("08:00"), ParseTimeOfDay("08:00"), 0),
+		Entry(
+			"ignores location",
+			ParseTimeOfDay("08:00 Europe/Berlin"),
+			ParseTimeOfDay("08:00"),
+			0,
+		),
+		Entry(
+			"without location",
+			libtime.TimeOfDay{Hour: 8},
+			libtime.TimeOfDay{Hour: 9},
+			-1,
+		),
+	)
+	It("returns SecondsSinceMidnight", func() {
+		Expect(ParseTimeOfDay("01:02:03.9").SecondsSinceMidnight()).To(Equal(3723))
+		Expect(libtime.TimeOfDay{}.SecondsSinceMidnight()).To(Equal(0))
+	})
+	DescribeTable("On",
+		func(input string, date string, expected string) {
+			Expect(ParseTimeOfDay(input).On(ParseDate(date)).UTC().String()).To(Equal(expected))
+		},
+		Entry("utc", "13:37", "2024-12-24", "2024-12-24T13:37:00Z"),
+		Entry("berlin winter", "13:37 Europe/Berlin", "2024-12-24", "2024-12-24T12:37:00Z"),
+		Entry("berlin summer", "13:37 Europe/Berlin", "2024-07-24", "2024-07-24T11:37:00Z"),
+		Entry("spring forward gap", "02:30 Europe/Berlin", "2024-03-31", "2024-03-31T01:30:00Z"),
+		Entry("fall back earlier", "02:30 Europe/Berlin", "2024-10-27", "2024-10-27T00:30:00Z"),
+		Entry("new york gap", "02:30 America/New_York", "2024-03-10", "2024-03-10T07:30:00Z"),
+	)
+	It("treats a nil location as UTC", func() {
+		result := libtime.TimeOfDay{Hour: 8}.On(ParseDate("2024-03-31"))
+		Expect(result.String()).To(Equal("2024-03-31T08:00:00Z"))
+	})
+	DescribeTable("OnWithResolver",
+		func(
+			input string,
+			date string,
+			resolver libtime.LocalTimeResolver,
+			expected string,
+			expectError bool,
+		) {
+			result, err := ParseTimeOfDay(input).OnWithResolver(ctx, ParseDate(date), resolver)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(result.UTC().String()).To(Equal(expected))
+		},
+		Entry(
+			"gap shift forward",
+			"02:30 Europe/Berlin", "2024-03-31", libtime.LocalTimeResolverShiftForward,
+			"2024-03-31T01:30:00Z", false,
+		),
+		Entry(
+			"gap earlier",
+			"02:30 Europe/Berlin", "2024-03-31", libtime.LocalTimeResolverEarlier,
+			"2024-03-31T00:30:00Z", false,
+		),
+		Entry(
+			"gap reject",
+			"02:30 Europe/Berlin", "2024-03-31", libtime.LocalTimeResolverReject,
+			"", true,
+		),
+		Entry(
+			"fall back shift forward",
+			"02:30 Europe/Berlin", "2024-10-27", libtime.LocalTimeResolverShiftForward,
+			"2024-10-27T00:30:00Z", false,
+		),
+		Entry(
+			"fall back earlier",
+			"02:30 Europe/Berlin", "2024-10-27", libtime.LocalTimeResolverEarlier,
+			"2024-10-27T00:30:00Z", false,
+		),
+		Entry(
+			"fall back later",
+			"02:30 Europe/Berlin", "2024-10-27", libtime.LocalTimeResolverLater,
+			"2024-10-27T01:30:00Z", false,
+		),
+		Entry(
+			"fall back reject",
+			"02:30 Europe/Berlin", "2024-10-27", libtime.LocalTimeResolverReject,
+			"", true,
+		),
+		Entry(
+			"existing time reject",
+			"03:30 Europe/Berlin", "2024-03-31", libtime.LocalTimeResolverReject,
+			"2024-03-31T01:30:00Z", false,
+		),
+		Entry(
+			"unknown resolver",
+			"03:30 Europe/Berlin", "2024-03-31", libtime.LocalTimeResolver(42),
+			"", true,
+		),
+	)
+	It("returns TimeWithResolver", func() {
+		result, err := ParseTimeOfDay("02:30 Europe/Berlin").
+			TimeWithResolver(ctx, 2024, time.October, 27, libtime.LocalTimeResolverLater)
+		Expect(err).To(BeNil())
+		Expect(result.UTC().Format(time.RFC3339)).To(Equal("2024-10-27T01:30:00Z"))
+	})
+	Context("comparisons on date", func() {
+		var berlin libtime.TimeOfDay
+		var utc libtime.TimeOfDay
+		BeforeEach(func() {
+			berlin = ParseTimeOfDay("12:30 Europe/Berlin")
+			utc = ParseTimeOfDay("11:00")
+		})
+		It("uses the summer offset in summer", func() {
+			summer := ParseDate("2024-07-01")
+			Expect(berlin.BeforeOn(summer, utc)).To(BeTrue())
+			Expect(berlin.AfterOn(summer, utc)).To(BeFalse())
+			Expect(berlin.CompareOn(summer, utc)).To(Equal(-1))
+		})
+		It("uses the winter offset in winter", func() {
+			winter := ParseDate("2024-01-15")
+			Expect(berlin.BeforeOn(winter, utc)).To(BeFalse())
+			Expect(berlin.AfterOn(winter, utc)).To(BeTrue())
+			Expect(berlin.CompareOn(winter, utc)).To(Equal(1))
+		})
+		It("detects equal instants", func() {
+			winter := ParseDate("2024-01-15")
+			Expect(ParseTimeOfDay("12:00 Europe/Berlin").EqualOn(winter, utc)).To(BeTrue())
+			Expect(ParseTimeOfDay("12:00 Europe/Berlin").EqualOn(ParseDate("2024-07-01"), utc)).
+				To(BeFalse())
+		})
+	})
 })
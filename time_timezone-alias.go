@@ -0,0 +1,324 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	stdtime "time"
+)
+
+// windowsLocationNames maps Windows time zone ids to IANA names like the
+// territory "001" entries of the CLDR windowsZones table. Keys are lower case.
+var windowsLocationNames = lowerKeys(map[string]string{
+	"Dateline Standard Time":          "Etc/GMT+12",
+	"UTC-11":                          "Etc/GMT+11",
+	"Aleutian Standard Time":          "America/Adak",
+	"Hawaiian Standard Time":          "Pacific/Honolulu",
+	"Marquesas Standard Time":         "Pacific/Marquesas",
+	"Alaskan Standard Time":           "America/Anchorage",
+	"UTC-09":                          "Etc/GMT+9",
+	"Pacific Standard Time (Mexico)":  "America/Tijuana",
+	"UTC-08":                          "Etc/GMT+8",
+	"Pacific Standard Time":           "America/Los_Angeles",
+	"US Mountain Standard Time":       "America/Phoenix",
+	"Mountain Standard Time (Mexico)": "America/Mazatlan",
+	"Mountain Standard Time":          "America/Denver",
+	"Yukon Standard Time":             "America/Whitehorse",
+	"Central America Standard Time":   "America/Guatemala",
+	"Central Standard Time":           "America/Chicago",
+	"Easter Island Standard Time":     "Pacific/Easter",
+	"Central Standard Time (Mexico)":  "America/Mexico_City",
+	"Canada Central Standard Time":    "America/Regina",
+	"SA Pacific Standard Time":        "America/Bogota",
+	"Eastern Standard Time (Mexico)":  "America/Cancun",
+	"Eastern Standard Time":           "America/New_York",
+	"Haiti Standard Time":             "America/Port-au-Prince",
+	"Cuba Standard Time":              "America/Havana",
+	"US Eastern Standard Time":        "America/Indiana/Indianapolis",
+	"Turks And Caicos Standard Time":  "America/Grand_Turk",
+	"Paraguay Standard Time":          "America/Asuncion",
+	"Atlantic Standard Time":          "America/Halifax",
+	"Venezuela Standard Time":         "America/Caracas",
+	"Central Brazilian Standard Time": "America/Cuiaba",
+	"SA Western Standard Time":        "America/La_Paz",
+	"Pacific SA Standard Time":        "America/Santiago",
+	"Newfoundland Standard Time":      "America/St_Johns",
+	"Tocantins Standard Time":         "America/Araguaina",
+	"E. South America Standard Time":  "America/Sao_Paulo",
+	"SA Eastern Standard Time":        "America/Cayenne",
+	"Argentina Standard Time":         "America/Argentina/Buenos_Aires",
+	"Greenland Standard Time":         "America/Nuuk",
+	"Montevideo Standard Time":        "America/Montevideo",
+	"Magallanes Standard Time":        "America/Punta_Arenas",
+	"Saint Pierre Standard Time":      "America/Miquelon",
+	"Bahia Standard Time":             "America/Bahia",
+	"UTC-02":                          "Etc/GMT+2",
+	"Mid-Atlantic Standard Time":      "Etc/GMT+2",
+	"Azores Standard Time":            "Atlantic/Azores",
+	"Cape Verde Standard Time":        "Atlantic/Cape_Verde",
+	"UTC":                             "Etc/UTC",
+	"GMT Standard Time":               "Europe/London",
+	"Greenwich Standard Time":         "Atlantic/Reykjavik",
+	"Sao Tome Standard Time":          "Africa/Sao_Tome",
+	"Morocco Standard Time":           "Africa/Casablanca",
+	"W. Europe Standard Time":         "Europe/Berlin",
+	"Central Europe Standard Time":    "Europe/Budapest",
+	"Romance Standard Time":           "Europe/Paris",
+	"Central European Standard Time":  "Europe/Warsaw",
+	"W. Central Africa Standard Time": "Africa/Lagos",
+	"Jordan Standard Time":            "Asia/Amman",
+	"GTB Standard Time":               "Europe/Bucharest",
+	"Middle East Standard Time":       "Asia/Beirut",
+	"Egypt Standard Time":             "Africa/Cairo",
+	"E. Europe Standard Time":         "Europe/Chisinau",
+	"Syria Standard Time":             "Asia/Damascus",
+	"West Bank Standard Time":         "Asia/Hebron",
+	"South Africa Standard Time":      "Africa/Johannesburg",
+	"FLE Standard Time":               "Europe/Kyiv",
+	"Israel Standard Time":            "Asia/Jerusalem",
+	"South Sudan Standard Time":       "Africa/Juba",
+	"Kaliningrad Standard Time":       "Europe/Kaliningrad",
+	"Sudan Standard Time":             "Africa/Khartoum",
+	"Libya Standard Time":             "Africa/Tripoli",
+	"Namibia Standard Time":           "Africa/Windhoek",
+	"Arabic Standard Time":            "Asia/Baghdad",
+	"Turkey Standard Time":            "Europe/Istanbul",
+	"Arab Standard Time":              "Asia/Riyadh",
+	"Belarus Standard Time":           "Europe/Minsk",
+	"Russian Standard Time":           "Europe/Moscow",
+	"E. Africa Standard Time":         "Africa/Nairobi",
+	"Volgograd Standard Time":         "Europe/Volgograd",
+	"Iran Standard Time":              "Asia/Tehran",
+	"Arabian Standard Time":           "Asia/Dubai",
+	"Astrakhan Standard Time":         "Europe/Astrakhan",
+	"Azerbaijan Standard Time":        "Asia/Baku",
+	"Russia Time Zone 3":              "Europe/Samara",
+	"Mauritius Standard Time":         "Indian/Mauritius",
+	"Saratov Standard Time":           "Europe/Saratov",
+	"Georgian Standard Time":          "Asia/Tbilisi",
+	"Caucasus Standard Time":          "Asia/Yerevan",
+	"Afghanistan Standard Time":       "Asia/Kabul",
+	"West Asia Standard Time":         "Asia/Tashkent",
+	"Ekaterinburg Standard Time":      "Asia/Yekaterinburg",
+	"Pakistan Standard Time":          "Asia/Karachi",
+	"Qyzylorda Standard Time":         "Asia/Qyzylorda",
+	"India Standard Time":             "Asia/Kolkata",
+	"Sri Lanka Standard Time":         "Asia/Colombo",
+	"Nepal Standard Time":             "Asia/Kathmandu",
+	"Central Asia Standard Time":      "Asia/Bishkek",
+	"Bangladesh Standard Time":        "Asia/Dhaka",
+	"Omsk Standard Time":              "Asia/Omsk",
+	"Myanmar Standard Time":           "Asia/Yangon",
+	"SE Asia Standard Time":           "Asia/Bangkok",
+	"Altai Standard Time":             "Asia/Barnaul",
+	"W. Mongolia Standard Time":       "Asia/Hovd",
+	"North Asia Standard Time":        "Asia/Krasnoyarsk",
+	"N. Central Asia Standard Time":   "Asia/Novosibirsk",
+	"Tomsk Standard Time":             "Asia/Tomsk",
+	"China Standard Time":             "Asia/Shanghai",
+	"North Asia East Standard Time":   "Asia/Irkutsk",
+	"Singapore Standard Time":         "Asia/Singapore",
+	"W. Australia Standard Time":      "Australia/Perth",
+	"Taipei Standard Time":            "Asia/Taipei",
+	"Ulaanbaatar Standard Time":       "Asia/Ulaanbaatar",
+	"Aus Central W. Standard Time":    "Australia/Eucla",
+	"Transbaikal Standard Time":       "Asia/Chita",
+	"Tokyo Standard Time":             "Asia/Tokyo",
+	"North Korea Standard Time":       "Asia/Pyongyang",
+	"Korea Standard Time":             "Asia/Seoul",
+	"Yakutsk Standard Time":           "Asia/Yakutsk",
+	"Cen. Australia Standard Time":    "Australia/Adelaide",
+	"AUS Central Standard Time":       "Australia/Darwin",
+	"E. Australia Standard Time":      "Australia/Brisbane",
+	"AUS Eastern Standard Time":       "Australia/Sydney",
+	"West Pacific Standard Time":      "Pacific/Port_Moresby",
+	"Tasmania Standard Time":          "Australia/Hobart",
+	"Vladivostok Standard Time":       "Asia/Vladivostok",
+	"Lord Howe Standard Time":         "Australia/Lord_Howe",
+	"Bougainville Standard Time":      "Pacific/Bougainville",
+	"Russia Time Zone 10":             "Asia/Srednekolymsk",
+	"Magadan Standard Time":           "Asia/Magadan",
+	"Norfolk Standard Time":           "Pacific/Norfolk",
+	"Sakhalin Standard Time":          "Asia/Sakhalin",
+	"Central Pacific Standard Time":   "Pacific/Guadalcanal",
+	"Russia Time Zone 11":             "Asia/Kamchatka",
+	"Kamchatka Standard Time":         "Asia/Kamchatka",
+	"New Zealand Standard Time":       "Pacific/Auckland",
+	"UTC+12":                          "Etc/GMT-12",
+	"Fiji Standard Time":              "Pacific/Fiji",
+	"Chatham Islands Standard Time":   "Pacific/Chatham",
+	"UTC+13":                          "Etc/GMT-13",
+	"Tonga Standard Time":             "Pacific/Tongatapu",
+	"Samoa Standard Time":             "Pacific/Apia",
+	"Line Islands Standard Time":      "Pacific/Kiritimati",
+})
+
+// linkedLocationNames maps deprecated and legacy names from the tzdata backward file
+// to their canonical IANA names.
+var linkedLocationNames = map[string]string{
+	"America/Buenos_Aires": "America/Argentina/Buenos_Aires",
+	"America/Godthab":      "America/Nuuk",
+	"America/Indianapolis": "America/Indiana/Indianapolis",
+	"Asia/Calcutta":        "Asia/Kolkata",
+	"Asia/Chongqing":       "Asia/Shanghai",
+	"Asia/Harbin":          "Asia/Shanghai",
+	"Asia/Katmandu":        "Asia/Kathmandu",
+	"Asia/Rangoon":         "Asia/Yangon",
+	"Asia/Saigon":          "Asia/Ho_Chi_Minh",
+	"Asia/Ulan_Bator":      "Asia/Ulaanbaatar",
+	"Atlantic/Faeroe":      "Atlantic/Faroe",
+	"Australia/ACT":        "Australia/Sydney",
+	"Australia/NSW":        "Australia/Sydney",
+	"Canada/Eastern":       "America/Toronto",
+	"Canada/Pacific":       "America/Vancouver",
+	"CET":                  "Europe/Brussels",
+	"CST6CDT":              "America/Chicago",
+	"EET":                  "Europe/Athens",
+	"Egypt":                "Africa/Cairo",
+	"Eire":                 "Europe/Dublin",
+	"EST":                  "America/Panama",
+	"EST5EDT":              "America/New_York",
+	"Etc/UCT":              "Etc/UTC",
+	"Etc/Universal":        "Etc/UTC",
+	"Etc/Zulu":             "Etc/UTC",
+	"Europe/Kiev":          "Europe/Kyiv",
+	"Europe/Uzhgorod":      "Europe/Kyiv",
+	"Europe/Zaporozhye":    "Europe/Kyiv",
+	"GB":                   "Europe/London",
+	"Greenwich":            "Etc/GMT",
+	"HST":                  "Pacific/Honolulu",
+	"Iran":                 "Asia/Tehran",
+	"Israel":               "Asia/Jerusalem",
+	"Japan":                "Asia/Tokyo",
+	"MET":                  "Europe/Brussels",
+	"MST":                  "America/Phoenix",
+	"MST7MDT":              "America/Denver",
+	"NZ":                   "Pacific/Auckland",
+	"Pacific/Ponape":       "Pacific/Pohnpei",
+	"Pacific/Truk":         "Pacific/Chuuk",
+	"Poland":               "Europe/Warsaw",
+	"Portugal":             "Europe/Lisbon",
+	"PRC":                  "Asia/Shanghai",
+	"PST8PDT":              "America/Los_Angeles",
+	"ROK":                  "Asia/Seoul",
+	"Singapore":            "Asia/Singapore",
+	"Turkey":               "Europe/Istanbul",
+	"UCT":                  "Etc/UTC",
+	"Universal":            "Etc/UTC",
+	"US/Alaska":            "America/Anchorage",
+	"US/Arizona":           "America/Phoenix",
+	"US/Central":           "America/Chicago",
+	"US/Eastern":           "America/New_York",
+	"US/Hawaii":            "Pacific/Honolulu",
+	"US/Mountain":          "America/Denver",
+	"US/Pacific":           "America/Los_Angeles",
+	"WET":                  "Europe/Lisbon",
+	"Zulu":                 "Etc/UTC",
+}
+
+// deprecatedLocationNames maps canonical names to their deprecated links,
+// so systems with old tzdata still find Europe/Kyiv as Europe/Kiev.
+var deprecatedLocationNames = func() map[string][]string {
+	result := make(map[string][]string)
+	for deprecated, canonical := range linkedLocationNames {
+		result[canonical] = append(result[canonical], deprecated)
+	}
+	return result
+}()
+
+var utcOffsetRegexp = regexp.MustCompile(`^(?i:UTC|GMT)\s*([+-])(\d{1,2})(?::?(\d{2}))?$`)
+
+// CanonicalLocationName returns the canonical IANA name for name. It resolves Windows
+// time zone ids like "W. Europe Standard Time", deprecated links like "Europe/Kiev"
+// and offsets like "UTC+02:00", which become "Etc/GMT-2" for whole hours.
+// Other names are returned unchanged.
+func CanonicalLocationName(name string) string {
+	name = strings.TrimSpace(name)
+	if canonical, ok := windowsLocationNames[strings.ToLower(name)]; ok {
+		name = canonical
+	}
+	if canonical, ok := linkedLocationNames[name]; ok {
+		return canonical
+	}
+	if offset, ok := parseUTCOffset(name); ok {
+		return utcOffsetLocationName(offset)
+	}
+	return name
+}
+
+// loadLocationAlias loads name like time.LoadLocation and falls back to UTC offsets,
+// Windows ids and deprecated or canonical links.
+func loadLocationAlias(source LocationSource, name string) (*stdtime.Location, error) {
+	loc, err := source.LoadLocation(name)
+	if err == nil {
+		return loc, nil
+	}
+	trimmed := strings.TrimSpace(name)
+	if offset, ok := parseUTCOffset(trimmed); ok {
+		return stdtime.FixedZone(formatUTCOffset(offset), offset), nil
+	}
+	canonical := CanonicalLocationName(trimmed)
+	candidates := append([]string{trimmed, canonical}, deprecatedLocationNames[canonical]...)
+	for _, candidate := range candidates {
+		if loc, err := source.LoadLocation(candidate); err == nil {
+			return loc, nil
+		}
+	}
+	return nil, err
+}
+
+// parseUTCOffset parses offsets like "UTC+2", "GMT-05:30" or "UTC+0530" in seconds east
+// of UTC. Unlike the POSIX TZ format and Etc/GMT names a plus sign means ahead of UTC.
+func parseUTCOffset(name string) (int, bool) {
+	matches := utcOffsetRegexp.FindStringSubmatch(name)
+	if matches == nil {
+		return 0, false
+	}
+	hours, _ := strconv.Atoi(matches[2])
+	var minutes int
+	if matches[3] != "" {
+		minutes, _ = strconv.Atoi(matches[3])
+	}
+	if hours > 14 || minutes > 59 {
+		return 0, false
+	}
+	offset := hours*3600 + minutes*60
+	if matches[1] == "-" {
+		offset = -offset
+	}
+	return offset, true
+}
+
+// utcOffsetLocationName returns the Etc/GMT name for whole hours and "UTC+05:30" otherwise.
+func utcOffsetLocationName(offset int) string {
+	if offset == 0 {
+		return "Etc/UTC"
+	}
+	if offset%3600 != 0 || offset < -12*3600 {
+		return formatUTCOffset(offset)
+	}
+	// Etc/GMT names use the inverted POSIX sign
+	return fmt.Sprintf("Etc/GMT%+d", -offset/3600)
+}
+
+func formatUTCOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	return fmt.Sprintf("UTC%c%02d:%02d", sign, offset/3600, offset%3600/60)
+}
+
+func lowerKeys(values map[string]string) map[string]string {
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		result[strings.ToLower(key)] = value
+	}
+	return result
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	stdtime "time"
+
+	"github.com/bborbe/errors"
+)
+
+//counterfeiter:generate -o mocks/location-source.go --fake-name LocationSource . LocationSource
+
+// LocationSource loads locations from a time zone database.
+type LocationSource interface {
+	// Key identifies the source in the location cache.
+	Key() string
+	// Version returns the tzdata version like "2026c" or an empty string if unknown.
+	Version() string
+	// LoadLocation loads the location with the given IANA name.
+	LoadLocation(name string) (*stdtime.Location, error)
+}
+
+var locationSource atomic.Pointer[LocationSource]
+
+// SetLocationSource sets the source used by LoadLocation. Locations are cached per source,
+// so switching sources never returns locations loaded from another one.
+func SetLocationSource(source LocationSource) {
+	locationSource.Store(&source)
+}
+
+// GetLocationSource returns the source used by LoadLocation. Defaults to HostLocationSource.
+func GetLocationSource() LocationSource {
+	if source := locationSource.Load(); source != nil {
+		return *source
+	}
+	return HostLocationSource()
+}
+
+// TZDataVersion returns the tzdata version of the current location source
+// or an empty string if unknown.
+func TZDataVersion() string {
+	return GetLocationSource().Version()
+}
+
+// HostLocationSource returns the source using time.LoadLocation,
+// which reads the zoneinfo of the host or the tzdata embedded with time/tzdata.
+func HostLocationSource() LocationSource {
+	return hostLocationSource{}
+}
+
+type hostLocationSource struct{}
+
+func (hostLocationSource) Key() string {
+	return "host"
+}
+
+func (hostLocationSource) Version() string {
+	return ""
+}
+
+func (hostLocationSource) LoadLocation(name string) (*stdtime.Location, error) {
+	return stdtime.LoadLocation(name)
+}
+
+// NewZipLocationSource returns a source reading a zoneinfo zip file
+// like $GOROOT/lib/time/zoneinfo.zip. The file is read once.
+func NewZipLocationSource(ctx context.Context, path string) (LocationSource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "read zoneinfo zip '%s' failed", path)
+	}
+	key := path
+	if abs, err := filepath.Abs(path); err == nil {
+		key = abs
+	}
+	return NewZipDataLocationSource(ctx, "zip:"+key, "", data)
+}
+
+// NewZipDataLocationSource returns a source reading zoneinfo zip data, for example
+// embedded with go:embed. An empty version is read from a "+VERSION" entry if present.
+func NewZipDataLocationSource(
+	ctx context.Context,
+	key string,
+	version string,
+	data []byte,
+) (LocationSource, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "open zoneinfo zip failed")
+	}
+	source := &fsLocationSource{key: key, version: version, fs: reader}
+	if source.version == "" {
+		source.version = tzdataVersion(reader)
+	}
+	return source, nil
+}
+
+// NewDirLocationSource returns a source reading a zoneinfo directory like /usr/share/zoneinfo.
+// The version is read from its "+VERSION" or "tzdata.zi" file.
+func NewDirLocationSource(ctx context.Context, path string) (LocationSource, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "stat zoneinfo directory '%s' failed", path)
+	}
+	if !info.IsDir() {
+		return nil, errors.Errorf(ctx, "zoneinfo '%s' is not a directory", path)
+	}
+	key := path
+	if abs, err := filepath.Abs(path); err == nil {
+		key = abs
+	}
+	dir := os.DirFS(path)
+	return &fsLocationSource{key: "dir:" + key, version: tzdataVersion(dir), fs: dir}, nil
+}
+
+type fsLocationSource struct {
+	key     string
+	version string
+	fs      fs.FS
+}
+
+func (f *fsLocationSource) Key() string {
+	return f.key
+}
+
+func (f *fsLocationSource) Version() string {
+	return f.version
+}
+
+func (f *fsLocationSource) LoadLocation(name string) (*stdtime.Location, error) {
+	switch name {
+	case "", "UTC":
+		return stdtime.UTC, nil
+	case "Local":
+		return stdtime.Local, nil
+	}
+	if !fs.ValidPath(name) {
+		return nil, errors.Errorf(context.Background(), "invalid location name '%s'", name)
+	}
+	data, err := fs.ReadFile(f.fs, name)
+	if err != nil {
+		return nil, errors.Wrapf(context.Background(), err, "unknown time zone %s", name)
+	}
+	return stdtime.LoadLocationFromTZData(name, data)
+}
+
+// tzdataVersion reads the version from "+VERSION" or the "# version" header of "tzdata.zi".
+func tzdataVersion(fsys fs.FS) string {
+	if data, err := fs.ReadFile(fsys, "+VERSION"); err == nil {
+		return strings.TrimSpace(string(data))
+	}
+	file, err := fsys.Open("tzdata.zi")
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+	line, err := bufio.NewReader(file).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return ""
+	}
+	if version, ok := strings.CutPrefix(strings.TrimSpace(line), "# version "); ok {
+		return version
+	}
+	return ""
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+	"github.com/bborbe/time/mocks"
+)
+
+// fixedTZif returns TZif data of a zone without transitions at the given offset.
+func fixedTZif(offset int32, abbreviation string) []byte {
+	result := []byte("TZif")
+	result = append(result, make([]byte, 16)...)
+	for _, count := range []uint32{0, 0, 0, 0, 1, uint32(len(abbreviation) + 1)} {
+		result = binary.BigEndian.AppendUint32(result, count)
+	}
+	result = binary.BigEndian.AppendUint32(result, uint32(offset))
+	result = append(result, 0, 0)
+	return append(append(result, abbreviation...), 0)
+}
+
+func writeZoneinfoDir(dir string, files map[string][]byte) {
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, content, 0o600)).To(Succeed())
+	}
+}
+
+func writeZoneinfoZip(path string, files map[string][]byte) {
+	file, err := os.Create(path)
+	Expect(err).To(BeNil())
+	defer file.Close()
+	writer := zip.NewWriter(file)
+	for name, content := range files {
+		entry, err := writer.Create(name)
+		Expect(err).To(BeNil())
+		_, err = entry.Write(content)
+		Expect(err).To(BeNil())
+	}
+	Expect(writer.Close()).To(Succeed())
+}
+
+var _ = Describe("LocationSource", func() {
+	var ctx context.Context
+	var dir string
+	var files map[string][]byte
+	var july libtime.DateTime
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+		files = map[string][]byte{
+			"+VERSION":      []byte("2099a\n"),
+			"Europe/Berlin": fixedTZif(3600, "PIN"),
+			"Test/Zone":     fixedTZif(-5*3600, "TST"),
+		}
+		july = libtime.DateTime(time.Date(2024, time.July, 1, 12, 0, 0, 0, time.UTC))
+	})
+	It("defaults to the host", func() {
+		Expect(libtime.GetLocationSource().Key()).To(Equal("host"))
+		Expect(libtime.TZDataVersion()).To(Equal(""))
+	})
+	Context("dir", func() {
+		var source libtime.LocationSource
+		BeforeEach(func() {
+			writeZoneinfoDir(dir, files)
+			var err error
+			source, err = libtime.NewDirLocationSource(ctx, dir)
+			Expect(err).To(BeNil())
+		})
+		It("reads the version", func() {
+			Expect(source.Version()).To(Equal("2099a"))
+			Expect(source.Key()).To(Equal("dir:" + dir))
+		})
+		It("loads locations", func() {
+			location, err := libtime.LoadLocationFromSource(ctx, source, "Test/Zone")
+			Expect(err).To(BeNil())
+			Expect(location.String()).To(Equal("Test/Zone"))
+			Expect(libtime.OffsetAt(location, july)).To(Equal(-5 * libtime.Hour))
+		})
+		It("loads UTC and offsets without zone files", func() {
+			location, err := libtime.LoadLocationFromSource(ctx, source, "UTC")
+			Expect(err).To(BeNil())
+			Expect(location).To(Equal(time.UTC))
+			location, err = libtime.LoadLocationFromSource(ctx, source, "UTC+02:00")
+			Expect(err).To(BeNil())
+			Expect(libtime.OffsetAt(location, july)).To(Equal(2 * libtime.Hour))
+		})
+		It("returns an error for unknown and invalid names", func() {
+			_, err := libtime.LoadLocationFromSource(ctx, source, "Europe/Paris")
+			Expect(err).NotTo(BeNil())
+			_, err = libtime.LoadLocationFromSource(ctx, source, "../Test/Zone")
+			Expect(err).NotTo(BeNil())
+		})
+		It("reads the version from tzdata.zi", func() {
+			Expect(os.Remove(filepath.Join(dir, "+VERSION"))).To(Succeed())
+			writeZoneinfoDir(dir, map[string][]byte{"tzdata.zi": []byte("# version 2099b\nZ x\n")})
+			source, err := libtime.NewDirLocationSource(ctx, dir)
+			Expect(err).To(BeNil())
+			Expect(source.Version()).To(Equal("2099b"))
+		})
+		It("returns an error for missing directories", func() {
+			_, err := libtime.NewDirLocationSource(ctx, filepath.Join(dir, "missing"))
+			Expect(err).NotTo(BeNil())
+			_, err = libtime.NewDirLocationSource(ctx, filepath.Join(dir, "+VERSION"))
+			Expect(err).NotTo(BeNil())
+		})
+	})
+	Context("zip", func() {
+		var path string
+		BeforeEach(func() {
+			path = filepath.Join(dir, "zoneinfo.zip")
+			writeZoneinfoZip(path, files)
+		})
+		It("loads locations", func() {
+			source, err := libtime.NewZipLocationSource(ctx, path)
+			Expect(err).To(BeNil())
+			Expect(source.Key()).To(Equal("zip:" + path))
+			Expect(source.Version()).To(Equal("2099a"))
+			location, err := libtime.LoadLocationFromSource(ctx, source, "Test/Zone")
+			Expect(err).To(BeNil())
+			Expect(libtime.OffsetAt(location, july)).To(Equal(-5 * libtime.Hour))
+		})
+		It("uses the given version", func() {
+			data, err := os.ReadFile(path)
+			Expect(err).To(BeNil())
+			source, err := libtime.NewZipDataLocationSource(ctx, "pinned", "2100a", data)
+			Expect(err).To(BeNil())
+			Expect(source.Key()).To(Equal("pinned"))
+			Expect(source.Version()).To(Equal("2100a"))
+		})
+		It("returns an error for invalid zips", func() {
+			_, err := libtime.NewZipLocationSource(ctx, filepath.Join(dir, "missing.zip"))
+			Expect(err).NotTo(BeNil())
+			_, err = libtime.NewZipDataLocationSource(ctx, "invalid", "", []byte("banana"))
+			Expect(err).NotTo(BeNil())
+		})
+	})
+	Context("SetLocationSource", func() {
+		var source libtime.LocationSource
+		BeforeEach(func() {
+			writeZoneinfoDir(dir, files)
+			var err error
+			source, err = libtime.NewDirLocationSource(ctx, dir)
+			Expect(err).To(BeNil())
+			previous := libtime.GetLocationSource()
+			DeferCleanup(func() {
+				libtime.SetLocationSource(previous)
+			})
+		})
+		It("pins zone rules independently of the host", func() {
+			host, err := libtime.LoadLocation(ctx, "Europe/Berlin")
+			Expect(err).To(BeNil())
+			Expect(libtime.OffsetAt(host, july)).To(Equal(2 * libtime.Hour))
+
+			libtime.SetLocationSource(source)
+			Expect(libtime.TZDataVersion()).To(Equal("2099a"))
+			pinned, err := libtime.LoadLocation(ctx, "Europe/Berlin")
+			Expect(err).To(BeNil())
+			Expect(libtime.OffsetAt(pinned, july)).To(Equal(libtime.Hour))
+
+			libtime.SetLocationSource(libtime.HostLocationSource())
+			host, err = libtime.LoadLocation(ctx, "Europe/Berlin")
+			Expect(err).To(BeNil())
+			Expect(libtime.OffsetAt(host, july)).To(Equal(2 * libtime.Hour))
+		})
+		It("is used by ParseLocation", func() {
+			libtime.SetLocationSource(source)
+			location, err := libtime.ParseLocation(ctx, "Test/Zone")
+			Expect(err).To(BeNil())
+			Expect(location.String()).To(Equal("Test/Zone"))
+		})
+	})
+	It("caches locations per source", func() {
+		source := &mocks.LocationSource{}
+		source.KeyReturns("mock:" + dir)
+		source.LoadLocationReturns(time.FixedZone("Mock", 3600), nil)
+		for i := 0; i < 3; i++ {
+			location, err := libtime.LoadLocationFromSource(ctx, source, "Mock/Zone")
+			Expect(err).To(BeNil())
+			Expect(location.String()).To(Equal("Mock"))
+		}
+		Expect(source.LoadLocationCallCount()).To(Equal(1))
+		Expect(source.LoadLocationArgsForCall(0)).To(Equal("Mock/Zone"))
+	})
+})
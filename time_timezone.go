@@ -15,22 +15,131 @@ import (
 
 var tzCache sync.Map
 
+// tzCacheKey separates cached locations of different sources.
+type tzCacheKey struct {
+	source string
+	name   string
+}
+
+// LoadLocation loads the location with the given IANA name from the current location source,
+// see SetLocationSource. It also accepts Windows time zone ids like "W. Europe Standard Time",
+// deprecated or renamed links like "Europe/Kiev" and offsets like "UTC+02:00".
+// Locations are cached by source and name.
 func LoadLocation(ctx context.Context, name string) (*stdtime.Location, error) {
-	if loc, ok := tzCache.Load(name); ok {
+	return LoadLocationFromSource(ctx, GetLocationSource(), name)
+}
+
+// LoadLocationFromSource loads the location like LoadLocation from the given source.
+func LoadLocationFromSource(
+	ctx context.Context,
+	source LocationSource,
+	name string,
+) (*stdtime.Location, error) {
+	key := tzCacheKey{source: source.Key(), name: name}
+	if loc, ok := tzCache.Load(key); ok {
 		return loc.(*stdtime.Location), nil
 	}
-	loc, err := stdtime.LoadLocation(name)
+	loc, err := loadLocationAlias(source, name)
 	if err != nil {
 		return nil, errors.Wrapf(ctx, err, "load location '%s' failed", name)
 	}
-	tzCache.Store(name, loc)
+	tzCache.Store(key, loc)
 	return loc, nil
 }
 
+// LoadCanonicalLocation loads the location like LoadLocation under its canonical
+// IANA name, so "W. Europe Standard Time" returns Europe/Berlin.
+func LoadCanonicalLocation(ctx context.Context, name string) (*stdtime.Location, error) {
+	return LoadLocation(ctx, CanonicalLocationName(name))
+}
+
+// ParseLocation loads a location name like "Europe/Berlin" with LoadLocation.
+// It also accepts *time.Location and HasLocation like Location.
 func ParseLocation(ctx context.Context, value any) (*stdtime.Location, error) {
+	switch v := value.(type) {
+	case *stdtime.Location:
+		if v == nil {
+			return nil, errors.Errorf(ctx, "location is nil")
+		}
+		return v, nil
+	case *Location:
+		if v == nil {
+			return nil, errors.Errorf(ctx, "location is nil")
+		}
+		return v.Location(), nil
+	case HasLocation:
+		return v.Location(), nil
+	}
 	str, err := libparse.ParseString(ctx, value)
 	if err != nil {
 		return nil, errors.Wrapf(ctx, err, "parse value as string failed")
 	}
 	return LoadLocation(ctx, str)
 }
+
+// ZoneTransition is a change of the UTC offset or the abbreviation of a location,
+// like the switch from CET to CEST.
+type ZoneTransition struct {
+	At                 DateTime `json:"at"`
+	OffsetBefore       Duration `json:"offsetBefore"`
+	OffsetAfter        Duration `json:"offsetAfter"`
+	AbbreviationBefore string   `json:"abbreviationBefore"`
+	AbbreviationAfter  string   `json:"abbreviationAfter"`
+}
+
+// Change returns how far clocks move, positive when they spring forward.
+func (z ZoneTransition) Change() Duration {
+	return z.OffsetAfter - z.OffsetBefore
+}
+
+// OffsetAt returns the UTC offset of loc at dateTime. A nil loc is treated as UTC.
+func OffsetAt(loc *stdtime.Location, dateTime DateTime) Duration {
+	_, offset := dateTime.Time().In(locationOrUTC(loc)).Zone()
+	return Duration(offset) * Second
+}
+
+// NextTransition returns the first transition of loc after dateTime
+// or nil if loc has no further transitions.
+func NextTransition(loc *stdtime.Location, dateTime DateTime) *ZoneTransition {
+	t := dateTime.Time().In(locationOrUTC(loc))
+	for {
+		_, end := t.ZoneBounds()
+		if end.IsZero() || !end.After(t) {
+			return nil
+		}
+		nameBefore, offsetBefore := t.Zone()
+		nameAfter, offsetAfter := end.Zone()
+		if nameBefore != nameAfter || offsetBefore != offsetAfter {
+			return &ZoneTransition{
+				At:                 DateTime(end),
+				OffsetBefore:       Duration(offsetBefore) * Second,
+				OffsetAfter:        Duration(offsetAfter) * Second,
+				AbbreviationBefore: nameBefore,
+				AbbreviationAfter:  nameAfter,
+			}
+		}
+		t = end
+	}
+}
+
+// Transitions returns all transitions of loc within dateTimeRange in order.
+func Transitions(loc *stdtime.Location, dateTimeRange DateTimeRange) []ZoneTransition {
+	result := []ZoneTransition{}
+	// transitions exactly at From are included
+	dateTime := dateTimeRange.From.Add(-Nanosecond)
+	for {
+		transition := NextTransition(loc, dateTime)
+		if transition == nil || transition.At.After(dateTimeRange.Until) {
+			return result
+		}
+		result = append(result, *transition)
+		dateTime = transition.At
+	}
+}
+
+func locationOrUTC(loc *stdtime.Location) *stdtime.Location {
+	if loc == nil {
+		return stdtime.UTC
+	}
+	return loc
+}
@@ -6,6 +6,7 @@ package time_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -50,4 +51,133 @@ var _ = Describe("Location", func() {
 		Entry("Europe/Berlin", "Europe/Berlin", "Europe/Berlin", false),
 		Entry("Banana", "Banana", "", true),
 	)
+	DescribeTable("LoadLocation aliases",
+		func(input string, expectedLocation string, expectedOffset libtime.Duration) {
+			location, err := libtime.LoadLocation(ctx, input)
+			Expect(err).To(BeNil())
+			Expect(location.String()).To(Equal(expectedLocation))
+			Expect(libtime.OffsetAt(location, ParseDateTime("2024-01-15T12:00:00Z"))).
+				To(Equal(expectedOffset))
+		},
+		Entry("windows", "W. Europe Standard Time", "Europe/Berlin", libtime.Hour),
+		Entry("windows lower case", "eastern standard time", "America/New_York", -5*libtime.Hour),
+		Entry(
+			"windows with spaces",
+			" India Standard Time ",
+			"Asia/Kolkata",
+			5*libtime.Hour+30*libtime.Minute,
+		),
+		Entry("deprecated link", "Europe/Kiev", "Europe/Kiev", 2*libtime.Hour),
+		Entry("legacy", "EST5EDT", "EST5EDT", -5*libtime.Hour),
+		Entry("utc offset", "UTC+02:00", "UTC+02:00", 2*libtime.Hour),
+		Entry("gmt offset", "GMT+2", "UTC+02:00", 2*libtime.Hour),
+		Entry("negative offset", "UTC-0530", "UTC-05:30", -5*libtime.Hour-30*libtime.Minute),
+		Entry("iana etc", "Etc/GMT+2", "Etc/GMT+2", -2*libtime.Hour),
+	)
+	It("caches alias locations", func() {
+		first, err := libtime.LoadLocation(ctx, "Romance Standard Time")
+		Expect(err).To(BeNil())
+		second, err := libtime.LoadLocation(ctx, "Romance Standard Time")
+		Expect(err).To(BeNil())
+		Expect(second).To(BeIdenticalTo(first))
+	})
+	It("rejects invalid offsets", func() {
+		_, err := libtime.LoadLocation(ctx, "UTC+15")
+		Expect(err).NotTo(BeNil())
+		_, err = libtime.LoadLocation(ctx, "UTC+02:75")
+		Expect(err).NotTo(BeNil())
+	})
+	DescribeTable("CanonicalLocationName",
+		func(input string, expected string) {
+			Expect(libtime.CanonicalLocationName(input)).To(Equal(expected))
+		},
+		Entry("canonical", "Europe/Berlin", "Europe/Berlin"),
+		Entry("windows", "W. Europe Standard Time", "Europe/Berlin"),
+		Entry("windows fle", "FLE Standard Time", "Europe/Kyiv"),
+		Entry("windows utc offset", "UTC-08", "Etc/GMT+8"),
+		Entry("deprecated link", "Europe/Kiev", "Europe/Kyiv"),
+		Entry("backward link", "US/Eastern", "America/New_York"),
+		Entry("legacy cet", "CET", "Europe/Brussels"),
+		Entry("legacy est5edt", "EST5EDT", "America/New_York"),
+		Entry("whole hour offset", "GMT+2", "Etc/GMT-2"),
+		Entry("negative whole hour offset", "UTC-05:00", "Etc/GMT+5"),
+		Entry("zero offset", "UTC+00:00", "Etc/UTC"),
+		Entry("partial hour offset", "UTC+05:30", "UTC+05:30"),
+		Entry("unknown", "Banana", "Banana"),
+	)
+	DescribeTable("LoadCanonicalLocation",
+		func(input string, expectedLocation string) {
+			location, err := libtime.LoadCanonicalLocation(ctx, input)
+			Expect(err).To(BeNil())
+			Expect(location.String()).To(Equal(expectedLocation))
+		},
+		Entry("windows", "W. Europe Standard Time", "Europe/Berlin"),
+		Entry("deprecated link", "Europe/Kiev", "Europe/Kyiv"),
+		Entry("offset", "GMT+2", "Etc/GMT-2"),
+		Entry("partial hour offset", "UTC+05:30", "UTC+05:30"),
+	)
+	Context("transitions", func() {
+		var berlin *time.Location
+		BeforeEach(func() {
+			var err error
+			berlin, err = libtime.LoadLocation(ctx, "Europe/Berlin")
+			Expect(err).To(BeNil())
+		})
+		DescribeTable("OffsetAt",
+			func(dateTime string, expected libtime.Duration) {
+				Expect(libtime.OffsetAt(berlin, ParseDateTime(dateTime))).To(Equal(expected))
+			},
+			Entry("winter", "2024-01-15T12:00:00Z", libtime.Hour),
+			Entry("summer", "2024-07-15T12:00:00Z", 2*libtime.Hour),
+			Entry("just before spring forward", "2024-03-31T00:59:59Z", libtime.Hour),
+			Entry("at spring forward", "2024-03-31T01:00:00Z", 2*libtime.Hour),
+		)
+		It("returns zero offset for nil location", func() {
+			Expect(libtime.OffsetAt(nil, ParseDateTime("2024-07-15T12:00:00Z"))).
+				To(Equal(libtime.Duration(0)))
+		})
+		It("returns the next transition", func() {
+			transition := libtime.NextTransition(berlin, ParseDateTime("2024-01-15T12:00:00Z"))
+			Expect(transition).NotTo(BeNil())
+			Expect(transition.At.UTC().String()).To(Equal("2024-03-31T01:00:00Z"))
+			Expect(transition.OffsetBefore).To(Equal(libtime.Hour))
+			Expect(transition.OffsetAfter).To(Equal(2 * libtime.Hour))
+			Expect(transition.AbbreviationBefore).To(Equal("CET"))
+			Expect(transition.AbbreviationAfter).To(Equal("CEST"))
+			Expect(transition.Change()).To(Equal(libtime.Hour))
+		})
+		It("returns the transition strictly after the given time", func() {
+			transition := libtime.NextTransition(berlin, ParseDateTime("2024-03-31T01:00:00Z"))
+			Expect(transition).NotTo(BeNil())
+			Expect(transition.At.UTC().String()).To(Equal("2024-10-27T01:00:00Z"))
+			Expect(transition.Change()).To(Equal(-libtime.Hour))
+		})
+		It("returns nil without transitions", func() {
+			Expect(libtime.NextTransition(time.UTC, ParseDateTime("2024-01-15T12:00:00Z"))).To(BeNil())
+			Expect(libtime.NextTransition(nil, ParseDateTime("2024-01-15T12:00:00Z"))).To(BeNil())
+		})
+		It("returns transitions far in the future", func() {
+			transition := libtime.NextTransition(berlin, ParseDateTime("2100-01-01T00:00:00Z"))
+			Expect(transition).NotTo(BeNil())
+			Expect(transition.At.UTC().String()).To(Equal("2100-03-28T01:00:00Z"))
+		})
+		It("returns all transitions within a range", func() {
+			result := libtime.Transitions(berlin, libtime.DateTimeRange{
+				From:  ParseDateTime("2024-03-31T01:00:00Z"),
+				Until: ParseDateTime("2025-12-31T00:00:00Z"),
+			})
+			Expect(result).To(HaveLen(4))
+			Expect(result[0].At.UTC().String()).To(Equal("2024-03-31T01:00:00Z"))
+			Expect(result[1].At.UTC().String()).To(Equal("2024-10-27T01:00:00Z"))
+			Expect(result[2].At.UTC().String()).To(Equal("2025-03-30T01:00:00Z"))
+			Expect(result[3].At.UTC().String()).To(Equal("2025-10-26T01:00:00Z"))
+		})
+		It("returns no transitions for ranges without changes", func() {
+			result := libtime.Transitions(berlin, libtime.DateTimeRange{
+				From:  ParseDateTime("2024-04-01T00:00:00Z"),
+				Until: ParseDateTime("2024-10-01T00:00:00Z"),
+			})
+			Expect(result).To(BeEmpty())
+		})
+	})
 })
@@ -0,0 +1,369 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	stdtime "time"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/parse"
+	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
+)
+
+// unixEpochTime is implemented by UnixMilliTime, UnixMicroTime and UnixNanoTime.
+type unixEpochTime interface {
+	HasTime
+	validation.HasValidation
+	Before(time HasTime) bool
+	After(time HasTime) bool
+}
+
+// epochType is the shared implementation of UnixMilliTime, UnixMicroTime and UnixNanoTime,
+// which only differ in the unit of the epoch they write to JSON and SQL.
+// Their methods delegate to it.
+type epochType[T unixEpochTime] struct {
+	// name is used in error messages like "unix milli time"
+	name      string
+	unit      stdtime.Duration
+	toEpoch   func(t stdtime.Time) int64
+	fromEpoch func(epoch int64) stdtime.Time
+	// of converts to T
+	of func(t stdtime.Time) T
+}
+
+// ptr converts t to *T and keeps nil.
+func (e epochType[T]) ptr(t *stdtime.Time) *T {
+	if t == nil {
+		return nil
+	}
+	result := e.of(*t)
+	return &result
+}
+
+// at returns the time of the epoch in the unit.
+func (e epochType[T]) at(epoch int64) T {
+	return e.of(e.fromEpoch(epoch))
+}
+
+// parse reads an epoch in the unit or a time like ParseTime.
+func (e epochType[T]) parse(ctx context.Context, value interface{}) (*T, error) {
+	number, err := parse.ParseInt64(ctx, value)
+	if err == nil {
+		t := e.at(number)
+		return &t, nil
+	}
+	str, err := parse.ParseString(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse value failed")
+	}
+	t, err := ParseTime(ctx, str)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse time failed")
+	}
+	return e.ptr(t), nil
+}
+
+// parseDefault is parse returning defaultValue on error.
+func (e epochType[T]) parseDefault(ctx context.Context, value interface{}, defaultValue T) T {
+	result, err := e.parse(ctx, value)
+	if err != nil {
+		return defaultValue
+	}
+	return *result
+}
+
+func (e epochType[T]) fromBinary(ctx context.Context, value []byte) (*T, error) {
+	var t stdtime.Time
+	if err := t.UnmarshalBinary(value); err != nil {
+		return nil, errors.Wrapf(ctx, err, "unmarshalBinary failed")
+	}
+	return e.ptr(&t), nil
+}
+
+// epoch returns t in the unit or an error if t is outside the int64 range of the unit.
+func (e epochType[T]) epoch(ctx context.Context, t stdtime.Time) (int64, error) {
+	if t.Before(e.fromEpoch(math.MinInt64)) || !t.Before(e.fromEpoch(math.MaxInt64).Add(e.unit)) {
+		return 0, errors.Wrapf(
+			ctx,
+			validation.Error,
+			"%s is outside the range of %s",
+			t.Format(stdtime.RFC3339Nano),
+			e.name,
+		)
+	}
+	return e.toEpoch(t), nil
+}
+
+// unmarshalJSON reads the epoch in the unit. null results in the zero time.
+func (e epochType[T]) unmarshalJSON(target *T, b []byte) error {
+	str := strings.Trim(string(b), `"`)
+	if str == "null" {
+		*target = e.of(stdtime.Time{})
+		return nil
+	}
+	n, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return errors.Wrapf(context.Background(), err, "parse int failed")
+	}
+	*target = e.at(n)
+	return nil
+}
+
+// marshalJSON writes the epoch in the unit and the zero time as null.
+func (e epochType[T]) marshalJSON(t stdtime.Time) ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	epoch, err := e.epoch(context.Background(), t)
+	if err != nil {
+		return nil, errors.Wrapf(context.Background(), err, "marshal %s failed", e.name)
+	}
+	return json.Marshal(epoch)
+}
+
+// marshalText writes t as RFC 3339 and the zero time as empty text.
+func (e epochType[T]) marshalText(t stdtime.Time) ([]byte, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return []byte(t.Format(stdtime.RFC3339Nano)), nil
+}
+
+// unmarshalText reads times like ParseTime and empty text as the zero time.
+func (e epochType[T]) unmarshalText(target *T, b []byte) error {
+	str := string(b)
+	if len(str) == 0 {
+		*target = e.of(stdtime.Time{})
+		return nil
+	}
+	t, err := ParseTime(context.Background(), str)
+	if err != nil {
+		return errors.Wrapf(context.Background(), err, "parse time failed")
+	}
+	*target = e.of(*t)
+	return nil
+}
+
+// unmarshalYAML reads like parse. Empty values and "null" result in the zero time.
+func (e epochType[T]) unmarshalYAML(target *T, node *yaml.Node) error {
+	ctx := context.Background()
+	str, err := yamlScalar(ctx, node)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "decode yaml failed")
+	}
+	if str == "" {
+		*target = e.of(stdtime.Time{})
+		return nil
+	}
+	result, err := e.parse(ctx, str)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "parse '%s' at line %d failed", str, node.Line)
+	}
+	*target = *result
+	return nil
+}
+
+// scan reads integer epochs in the unit and timestamp columns. NULL results in the zero time.
+func (e epochType[T]) scan(target *T, src interface{}) error {
+	ctx := context.Background()
+	switch v := src.(type) {
+	case nil:
+		*target = e.of(stdtime.Time{})
+		return nil
+	case stdtime.Time:
+		*target = e.of(v)
+		return nil
+	case []byte:
+		src = string(v)
+	}
+	result, err := e.parse(ctx, src)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "scan %s failed", e.name)
+	}
+	*target = *result
+	return nil
+}
+
+// value returns the epoch in the unit. The zero time is stored as NULL.
+func (e epochType[T]) value(t stdtime.Time) (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	epoch, err := e.epoch(context.Background(), t)
+	if err != nil {
+		return nil, errors.Wrapf(context.Background(), err, "value %s failed", e.name)
+	}
+	return epoch, nil
+}
+
+// calendarRange returns the range from begin(t) to end(t), like the day or month of t.
+func (e epochType[T]) calendarRange(
+	t HasTime,
+	begin, end func(stdtime.Time) stdtime.Time,
+) epochRange[T] {
+	return epochRange[T]{From: e.of(begin(t.Time())), Until: e.of(end(t.Time()))}
+}
+
+// validateEpochTime fails for the zero time.
+func validateEpochTime(ctx context.Context, t HasTime) error {
+	if t.Time().IsZero() {
+		return errors.Wrapf(ctx, validation.Error, "time is zero")
+	}
+	return nil
+}
+
+func epochInterfaces[T any](values []T) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, value := range values {
+		result[i] = value
+	}
+	return result
+}
+
+func epochStrings[T fmt.Stringer](values []T) []string {
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = value.String()
+	}
+	return result
+}
+
+func epochClonePtr[T any](t *T) *T {
+	if t == nil {
+		return nil
+	}
+	clone := *t
+	return &clone
+}
+
+func epochTimePtr[T HasTime](t *T) *stdtime.Time {
+	if t == nil {
+		return nil
+	}
+	result := (*t).Time()
+	return &result
+}
+
+func epochEqualPtr[T HasTime](a, b *T) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a != nil && b != nil {
+		return (*a).Time().Equal((*b).Time())
+	}
+	return false
+}
+
+// epochComparePtr sorts nil before all times.
+func epochComparePtr[T HasTime](a, b *T) int {
+	if a == nil && b == nil {
+		return 0
+	}
+	if a == nil {
+		return -1
+	}
+	if b == nil {
+		return 1
+	}
+	return Compare((*a).Time(), (*b).Time())
+}
+
+// epochRange is the shared implementation of UnixMilliTimeRange, UnixMicroTimeRange
+// and UnixNanoTimeRange. They convert from and to it, because they have the same fields.
+type epochRange[T unixEpochTime] struct {
+	From  T
+	Until T
+}
+
+func (r epochRange[T]) validate(ctx context.Context) error {
+	return validation.All{
+		validation.Name("from", r.From),
+		validation.Name("until", r.Until),
+		validation.Name("range", validation.HasValidationFunc(func(ctx context.Context) error {
+			if r.From.After(r.Until) {
+				return errors.Wrapf(
+					ctx,
+					validation.Error,
+					"from must be less than or equal to until",
+				)
+			}
+			return nil
+		})),
+	}.Validate(ctx)
+}
+
+func (r epochRange[T]) timeRange() TimeRange {
+	return TimeRange{From: r.From.Time(), Until: r.Until.Time()}
+}
+
+// marshalYAML writes from and until. Zero sides are omitted.
+func (r epochRange[T]) marshalYAML() (interface{}, error) {
+	return yamlRange[T]{From: r.From, Until: r.Until}, nil
+}
+
+// unmarshalYAML reads from and until like the UnmarshalYAML of T.
+func (r *epochRange[T]) unmarshalYAML(node *yaml.Node) error {
+	result, err := unmarshalYAMLRange[T](node)
+	if err != nil {
+		return errors.Wrapf(context.Background(), err, "unmarshal range failed")
+	}
+	*r = epochRange[T]{From: result.From, Until: result.Until}
+	return nil
+}
+
+// maxEpochRange returns the range from the earliest From to the latest Until
+// or nil if ranges is empty. convert converts the typed ranges.
+func maxEpochRange[R any, T unixEpochTime](
+	ranges []R,
+	convert func(R) epochRange[T],
+) *epochRange[T] {
+	if len(ranges) == 0 {
+		return nil
+	}
+	maxRange := convert(ranges[0])
+	for _, typed := range ranges[1:] {
+		r := convert(typed)
+		if r.From.Before(maxRange.From) {
+			maxRange.From = r.From
+		}
+		if r.Until.After(maxRange.Until) {
+			maxRange.Until = r.Until
+		}
+	}
+	return &maxRange
+}
+
+// minEpochRange returns the range from the latest From to the earliest Until
+// or nil if ranges is empty or the ranges do not overlap. convert converts the typed ranges.
+func minEpochRange[R any, T unixEpochTime](
+	ranges []R,
+	convert func(R) epochRange[T],
+) *epochRange[T] {
+	if len(ranges) == 0 {
+		return nil
+	}
+	minRange := convert(ranges[0])
+	for _, typed := range ranges[1:] {
+		r := convert(typed)
+		if r.From.After(minRange.From) {
+			minRange.From = r.From
+		}
+		if r.Until.Before(minRange.Until) {
+			minRange.Until = r.Until
+		}
+	}
+	if minRange.From.After(minRange.Until) {
+		return nil
+	}
+	return &minRange
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	stdtime "time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UnixMicroTimeRange is a range of UnixMicroTime. Ranges of the epoch types share their implementation.
+type UnixMicroTimeRange struct {
+	From  UnixMicroTime `json:"from,omitempty"`
+	Until UnixMicroTime `json:"until,omitempty"`
+}
+
+type UnixMicroTimeRanges []UnixMicroTimeRange
+
+var _ yaml.Marshaler = UnixMicroTimeRange{}
+
+var _ yaml.Unmarshaler = (*UnixMicroTimeRange)(nil)
+
+// Max returns the range from the earliest From to the latest Until or nil if ranges is empty.
+func (ranges UnixMicroTimeRanges) Max() *UnixMicroTimeRange {
+	return (*UnixMicroTimeRange)(maxEpochRange(ranges, UnixMicroTimeRange.epochRange))
+}
+
+// Min returns the range from the latest From to the earliest Until
+// or nil if ranges is empty or the ranges do not overlap.
+func (ranges UnixMicroTimeRanges) Min() *UnixMicroTimeRange {
+	return (*UnixMicroTimeRange)(minEpochRange(ranges, UnixMicroTimeRange.epochRange))
+}
+
+func UnixMicroTimeRangeFromTime(from, until stdtime.Time) UnixMicroTimeRange {
+	return UnixMicroTimeRange{From: UnixMicroTime(from), Until: UnixMicroTime(until)}
+}
+
+// DayUnixMicroTimeRange returns the day containing ut like DayDateTimeRange.
+func DayUnixMicroTimeRange(ut UnixMicroTime) UnixMicroTimeRange {
+	return UnixMicroTimeRange(unixMicroTime.calendarRange(ut, BeginningOfDay, EndOfDay))
+}
+
+// WeekUnixMicroTimeRange returns the ISO week (Monday to Sunday) containing ut.
+func WeekUnixMicroTimeRange(ut UnixMicroTime) UnixMicroTimeRange {
+	return UnixMicroTimeRange(unixMicroTime.calendarRange(ut, BeginningOfWeek, EndOfWeek))
+}
+
+func MonthUnixMicroTimeRange(ut UnixMicroTime) UnixMicroTimeRange {
+	return UnixMicroTimeRange(unixMicroTime.calendarRange(ut, BeginningOfMonth, EndOfMonth))
+}
+
+func QuarterUnixMicroTimeRange(ut UnixMicroTime) UnixMicroTimeRange {
+	return UnixMicroTimeRange(unixMicroTime.calendarRange(ut, BeginningOfQuarter, EndOfQuarter))
+}
+
+func YearUnixMicroTimeRange(ut UnixMicroTime) UnixMicroTimeRange {
+	return UnixMicroTimeRange(unixMicroTime.calendarRange(ut, BeginningOfYear, EndOfYear))
+}
+
+func (r UnixMicroTimeRange) epochRange() epochRange[UnixMicroTime] {
+	return epochRange[UnixMicroTime](r)
+}
+
+func (r UnixMicroTimeRange) Validate(ctx context.Context) error {
+	return r.epochRange().validate(ctx)
+}
+
+func (r UnixMicroTimeRange) Ptr() *UnixMicroTimeRange {
+	return &r
+}
+
+func (r UnixMicroTimeRange) MarshalYAML() (interface{}, error) {
+	return r.epochRange().marshalYAML()
+}
+
+func (r *UnixMicroTimeRange) UnmarshalYAML(node *yaml.Node) error {
+	return (*epochRange[UnixMicroTime])(r).unmarshalYAML(node)
+}
+
+func (r UnixMicroTimeRange) TimeRange() TimeRange {
+	return r.epochRange().timeRange()
+}
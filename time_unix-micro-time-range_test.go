@@ -0,0 +1,50 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("UnixMicroTimeRange", func() {
+	var ctx context.Context
+	var input libtime.UnixMicroTime
+	BeforeEach(func() {
+		ctx = context.Background()
+		input = libtime.UnixMicroTime(time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC))
+	})
+	It("creates the day range", func() {
+		r := libtime.DayUnixMicroTimeRange(input)
+		Expect(r.From.String()).To(Equal("2024-06-15T00:00:00Z"))
+		Expect(r.Until.String()).To(Equal("2024-06-15T23:59:59.999999999Z"))
+		Expect(r.TimeRange().From).To(Equal(r.From.Time()))
+	})
+	It("creates the month range", func() {
+		r := libtime.MonthUnixMicroTimeRange(input)
+		Expect(r.From.String()).To(Equal("2024-06-01T00:00:00Z"))
+		Expect(r.Until.String()).To(Equal("2024-06-30T23:59:59.999999999Z"))
+	})
+	It("validates", func() {
+		r := libtime.DayUnixMicroTimeRange(input)
+		Expect(r.Validate(ctx)).To(BeNil())
+		r.From, r.Until = r.Until, r.From
+		Expect(r.Validate(ctx)).NotTo(BeNil())
+	})
+	It("returns min and max of ranges", func() {
+		ranges := libtime.UnixMicroTimeRanges{
+			libtime.DayUnixMicroTimeRange(input),
+			libtime.WeekUnixMicroTimeRange(input),
+		}
+		Expect(ranges.Max()).To(Equal(libtime.WeekUnixMicroTimeRange(input).Ptr()))
+		Expect(ranges.Min()).To(Equal(libtime.DayUnixMicroTimeRange(input).Ptr()))
+		Expect(libtime.UnixMicroTimeRanges{}.Max()).To(BeNil())
+	})
+})
@@ -0,0 +1,261 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	stdtime "time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UnixMicroTime is a point in time that marshals to JSON and SQL as epoch microseconds
+// and to text and YAML as RFC 3339. The zero UnixMicroTime is written as null.
+// Use it instead of UnixTime if microseconds must survive a JSON round trip.
+// The methods delegate to the implementation shared with the other epoch types.
+type UnixMicroTime stdtime.Time
+
+type UnixMicroTimes []UnixMicroTime
+
+var unixMicroTime = epochType[UnixMicroTime]{
+	name:      "unix micro time",
+	unit:      stdtime.Microsecond,
+	toEpoch:   stdtime.Time.UnixMicro,
+	fromEpoch: stdtime.UnixMicro,
+	of: func(t stdtime.Time) UnixMicroTime {
+		return UnixMicroTime(t)
+	},
+}
+
+var _ encoding.TextMarshaler = UnixMicroTime{}
+
+var _ encoding.TextUnmarshaler = (*UnixMicroTime)(nil)
+
+var _ sql.Scanner = (*UnixMicroTime)(nil)
+
+var _ driver.Valuer = UnixMicroTime{}
+
+var _ yaml.Marshaler = UnixMicroTime{}
+
+var _ yaml.Unmarshaler = (*UnixMicroTime)(nil)
+
+func (t UnixMicroTimes) Interfaces() []interface{} {
+	return epochInterfaces(t)
+}
+
+func (t UnixMicroTimes) Strings() []string {
+	return epochStrings(t)
+}
+
+func UnixMicroTimeFromBinary(ctx context.Context, value []byte) (*UnixMicroTime, error) {
+	return unixMicroTime.fromBinary(ctx, value)
+}
+
+func ParseUnixMicroTimeDefault(
+	ctx context.Context,
+	value interface{},
+	defaultValue UnixMicroTime,
+) UnixMicroTime {
+	return unixMicroTime.parseDefault(ctx, value, defaultValue)
+}
+
+// ParseUnixMicroTime accepts epoch microseconds and times like ParseTime.
+func ParseUnixMicroTime(ctx context.Context, value interface{}) (*UnixMicroTime, error) {
+	return unixMicroTime.parse(ctx, value)
+}
+
+func UnixMicroTimePtr(time *stdtime.Time) *UnixMicroTime {
+	return unixMicroTime.ptr(time)
+}
+
+func UnixMicroTimeFromMicro(usec int64) UnixMicroTime {
+	return unixMicroTime.at(usec)
+}
+
+// NewUnixMicroTime is time.Date returning a UnixMicroTime.
+func NewUnixMicroTime(
+	year int,
+	month stdtime.Month,
+	day, hour, min, sec, nsec int,
+	loc *stdtime.Location,
+) UnixMicroTime {
+	return UnixMicroTime(stdtime.Date(year, month, day, hour, min, sec, nsec, loc))
+}
+
+func (u UnixMicroTime) Year() int {
+	return u.Time().Year()
+}
+
+func (u UnixMicroTime) Month() stdtime.Month {
+	return u.Time().Month()
+}
+
+func (u UnixMicroTime) Day() int {
+	return u.Time().Day()
+}
+
+func (u UnixMicroTime) Hour() int {
+	return u.Time().Hour()
+}
+
+func (u UnixMicroTime) Minute() int {
+	return u.Time().Minute()
+}
+
+func (u UnixMicroTime) Second() int {
+	return u.Time().Second()
+}
+
+func (u UnixMicroTime) Nanosecond() int {
+	return u.Time().Nanosecond()
+}
+
+func (u UnixMicroTime) Equal(other UnixMicroTime) bool {
+	return u.Time().Equal(other.Time())
+}
+
+func (u *UnixMicroTime) EqualPtr(other *UnixMicroTime) bool {
+	return epochEqualPtr(u, other)
+}
+
+func (u UnixMicroTime) String() string {
+	return u.Format(stdtime.RFC3339Nano)
+}
+
+func (u UnixMicroTime) Validate(ctx context.Context) error {
+	return validateEpochTime(ctx, u)
+}
+
+func (u UnixMicroTime) Ptr() *UnixMicroTime {
+	return &u
+}
+
+func (u UnixMicroTime) Clone() UnixMicroTime {
+	return u
+}
+
+func (u *UnixMicroTime) ClonePtr() *UnixMicroTime {
+	return epochClonePtr(u)
+}
+
+func (u *UnixMicroTime) UnmarshalJSON(b []byte) error {
+	return unixMicroTime.unmarshalJSON(u, b)
+}
+
+func (u UnixMicroTime) MarshalJSON() ([]byte, error) {
+	return unixMicroTime.marshalJSON(u.Time())
+}
+
+func (u UnixMicroTime) MarshalText() ([]byte, error) {
+	return unixMicroTime.marshalText(u.Time())
+}
+
+func (u *UnixMicroTime) UnmarshalText(b []byte) error {
+	return unixMicroTime.unmarshalText(u, b)
+}
+
+func (u UnixMicroTime) MarshalYAML() (interface{}, error) {
+	return marshalYAMLText(u)
+}
+
+func (u *UnixMicroTime) UnmarshalYAML(node *yaml.Node) error {
+	return unixMicroTime.unmarshalYAML(u, node)
+}
+
+func (u *UnixMicroTime) Scan(src interface{}) error {
+	return unixMicroTime.scan(u, src)
+}
+
+func (u UnixMicroTime) Value() (driver.Value, error) {
+	return unixMicroTime.value(u.Time())
+}
+
+func (u UnixMicroTime) Time() stdtime.Time {
+	return stdtime.Time(u)
+}
+
+func (u *UnixMicroTime) TimePtr() *stdtime.Time {
+	return epochTimePtr(u)
+}
+
+func (u UnixMicroTime) Format(layout string) string {
+	return u.Time().Format(layout)
+}
+
+func (u UnixMicroTime) MarshalBinary() ([]byte, error) {
+	return u.Time().MarshalBinary()
+}
+
+func (u UnixMicroTime) Before(time HasTime) bool {
+	return u.Time().Before(time.Time())
+}
+
+func (u UnixMicroTime) After(time HasTime) bool {
+	return u.Time().After(time.Time())
+}
+
+func (u UnixMicroTime) Add(duration HasDuration) UnixMicroTime {
+	return UnixMicroTime(u.Time().Add(duration.Duration()))
+}
+
+func (u UnixMicroTime) Sub(time HasTime) Duration {
+	return Duration(u.Time().Sub(time.Time()))
+}
+
+func (u UnixMicroTime) Unix() int64 {
+	return u.Time().Unix()
+}
+
+func (u UnixMicroTime) UnixMilli() int64 {
+	return u.Time().UnixMilli()
+}
+
+func (u UnixMicroTime) UnixMicro() int64 {
+	return u.Time().UnixMicro()
+}
+
+func (u UnixMicroTime) UnixNano() int64 {
+	return u.Time().UnixNano()
+}
+
+func (u UnixMicroTime) Truncate(duration HasDuration) UnixMicroTime {
+	return UnixMicroTime(u.Time().Truncate(duration.Duration()))
+}
+
+func (u UnixMicroTime) Compare(other UnixMicroTime) int {
+	return Compare(u.Time(), other.Time())
+}
+
+func (u *UnixMicroTime) ComparePtr(other *UnixMicroTime) int {
+	return epochComparePtr(u, other)
+}
+
+func (u UnixMicroTime) DateTime() DateTime {
+	return DateTime(u)
+}
+
+// UnixTime converts to UnixTime, which marshals to JSON as epoch seconds.
+func (u UnixMicroTime) UnixTime() UnixTime {
+	return UnixTime(u)
+}
+
+func (u UnixMicroTime) AddDate(years int, months int, days int) UnixMicroTime {
+	return UnixMicroTime(u.Time().AddDate(years, months, days))
+}
+
+func (u UnixMicroTime) UTC() UnixMicroTime {
+	return UnixMicroTime(u.Time().UTC())
+}
+
+func (u UnixMicroTime) Weekday() Weekday {
+	return Weekday(u.Time().Weekday())
+}
+
+func (u UnixMicroTime) IsZero() bool {
+	return u.Time().IsZero()
+}
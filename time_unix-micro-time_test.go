@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("UnixMicroTime", func() {
+	var ctx context.Context
+	var unixMicroTime libtime.UnixMicroTime
+	BeforeEach(func() {
+		ctx = context.Background()
+		unixMicroTime = libtime.UnixMicroTime(time.Unix(1687161394, 123456000))
+	})
+	It("marshals JSON as epoch microseconds", func() {
+		bytes, err := json.Marshal(unixMicroTime)
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal(`1687161394123456`))
+	})
+	It("unmarshals JSON from epoch microseconds", func() {
+		var result libtime.UnixMicroTime
+		Expect(json.Unmarshal([]byte(`1687161394123456`), &result)).To(Succeed())
+		Expect(result.UTC().String()).To(Equal("2023-06-19T07:56:34.123456Z"))
+	})
+	It("keeps microseconds in a JSON round trip of a struct", func() {
+		type TestStruct struct {
+			Time libtime.UnixMicroTime `json:"time"`
+		}
+		bytes, err := json.Marshal(TestStruct{Time: unixMicroTime})
+		Expect(err).To(BeNil())
+		var result TestStruct
+		Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+		Expect(result.Time.Equal(unixMicroTime)).To(BeTrue())
+	})
+	It("round trips the zero time as JSON null and SQL NULL", func() {
+		bytes, err := json.Marshal(libtime.UnixMicroTime{})
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal(`null`))
+		result := libtime.UnixMicroTime(time.Now())
+		Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+		Expect(result.IsZero()).To(BeTrue())
+		value, err := libtime.UnixMicroTime{}.Value()
+		Expect(err).To(BeNil())
+		Expect(value).To(BeNil())
+		Expect(result.Scan(value)).To(Succeed())
+		Expect(result.IsZero()).To(BeTrue())
+	})
+	It("fails to marshal times outside the range of the unit", func() {
+		outOfRange := libtime.UnixMicroTime(time.Date(300000, time.January, 1, 0, 0, 0, 0, time.UTC))
+		_, err := json.Marshal(outOfRange)
+		Expect(err).NotTo(BeNil())
+		_, err = outOfRange.Value()
+		Expect(err).NotTo(BeNil())
+	})
+	It("fails to unmarshal invalid JSON", func() {
+		var result libtime.UnixMicroTime
+		Expect(json.Unmarshal([]byte(`"banana"`), &result)).NotTo(Succeed())
+	})
+	It("round trips text", func() {
+		bytes, err := unixMicroTime.MarshalText()
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal("2023-06-19T07:56:34.123456Z"))
+		var result libtime.UnixMicroTime
+		Expect(result.UnmarshalText(bytes)).To(Succeed())
+		Expect(result.Equal(unixMicroTime)).To(BeTrue())
+	})
+	It("round trips binary", func() {
+		bytes, err := unixMicroTime.MarshalBinary()
+		Expect(err).To(BeNil())
+		result, err := libtime.UnixMicroTimeFromBinary(ctx, bytes)
+		Expect(err).To(BeNil())
+		Expect(result.Equal(unixMicroTime)).To(BeTrue())
+	})
+	DescribeTable("ParseUnixMicroTime",
+		func(input any, expected string, expectedError bool) {
+			result, err := libtime.ParseUnixMicroTime(ctx, input)
+			if expectedError {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+				Expect(result).NotTo(BeNil())
+				Expect(result.UTC().String()).To(Equal(expected))
+			}
+		},
+		Entry("invalid", "banana", "", true),
+		Entry("dateTime", "2023-06-19T07:56:34.123456Z", "2023-06-19T07:56:34.123456Z", false),
+		Entry("number", int64(1687161394123456), "2023-06-19T07:56:34.123456Z", false),
+		Entry("string", "1687161394123456", "2023-06-19T07:56:34.123456Z", false),
+	)
+	It("returns the default on parse error", func() {
+		result := libtime.ParseUnixMicroTimeDefault(ctx, "banana", unixMicroTime)
+		Expect(result.Equal(unixMicroTime)).To(BeTrue())
+	})
+	It("validates", func() {
+		Expect(unixMicroTime.Validate(ctx)).To(BeNil())
+		Expect(libtime.UnixMicroTime{}.Validate(ctx)).NotTo(BeNil())
+	})
+	It("converts from and to UnixTime", func() {
+		Expect(unixMicroTime.UnixTime().UnixMicroTime().Equal(unixMicroTime)).To(BeTrue())
+		Expect(unixMicroTime.UnixMicro()).To(Equal(int64(1687161394123456)))
+		Expect(unixMicroTime.Unix()).To(Equal(int64(1687161394)))
+	})
+	It("creates from epoch microseconds", func() {
+		Expect(libtime.UnixMicroTimeFromMicro(1687161394123456).Equal(unixMicroTime)).To(BeTrue())
+	})
+})
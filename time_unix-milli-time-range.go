@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	stdtime "time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UnixMilliTimeRange is a range of UnixMilliTime. Ranges of the epoch types share their implementation.
+type UnixMilliTimeRange struct {
+	From  UnixMilliTime `json:"from,omitempty"`
+	Until UnixMilliTime `json:"until,omitempty"`
+}
+
+type UnixMilliTimeRanges []UnixMilliTimeRange
+
+var _ yaml.Marshaler = UnixMilliTimeRange{}
+
+var _ yaml.Unmarshaler = (*UnixMilliTimeRange)(nil)
+
+// Max returns the range from the earliest From to the latest Until or nil if ranges is empty.
+func (ranges UnixMilliTimeRanges) Max() *UnixMilliTimeRange {
+	return (*UnixMilliTimeRange)(maxEpochRange(ranges, UnixMilliTimeRange.epochRange))
+}
+
+// Min returns the range from the latest From to the earliest Until
+// or nil if ranges is empty or the ranges do not overlap.
+func (ranges UnixMilliTimeRanges) Min() *UnixMilliTimeRange {
+	return (*UnixMilliTimeRange)(minEpochRange(ranges, UnixMilliTimeRange.epochRange))
+}
+
+func UnixMilliTimeRangeFromTime(from, until stdtime.Time) UnixMilliTimeRange {
+	return UnixMilliTimeRange{From: UnixMilliTime(from), Until: UnixMilliTime(until)}
+}
+
+// DayUnixMilliTimeRange returns the day containing ut like DayDateTimeRange.
+func DayUnixMilliTimeRange(ut UnixMilliTime) UnixMilliTimeRange {
+	return UnixMilliTimeRange(unixMilliTime.calendarRange(ut, BeginningOfDay, EndOfDay))
+}
+
+// WeekUnixMilliTimeRange returns the ISO week (Monday to Sunday) containing ut.
+func WeekUnixMilliTimeRange(ut UnixMilliTime) UnixMilliTimeRange {
+	return UnixMilliTimeRange(unixMilliTime.calendarRange(ut, BeginningOfWeek, EndOfWeek))
+}
+
+func MonthUnixMilliTimeRange(ut UnixMilliTime) UnixMilliTimeRange {
+	return UnixMilliTimeRange(unixMilliTime.calendarRange(ut, BeginningOfMonth, EndOfMonth))
+}
+
+func QuarterUnixMilliTimeRange(ut UnixMilliTime) UnixMilliTimeRange {
+	return UnixMilliTimeRange(unixMilliTime.calendarRange(ut, BeginningOfQuarter, EndOfQuarter))
+}
+
+func YearUnixMilliTimeRange(ut UnixMilliTime) UnixMilliTimeRange {
+	return UnixMilliTimeRange(unixMilliTime.calendarRange(ut, BeginningOfYear, EndOfYear))
+}
+
+func (r UnixMilliTimeRange) epochRange() epochRange[UnixMilliTime] {
+	return epochRange[UnixMilliTime](r)
+}
+
+func (r UnixMilliTimeRange) Validate(ctx context.Context) error {
+	return r.epochRange().validate(ctx)
+}
+
+func (r UnixMilliTimeRange) Ptr() *UnixMilliTimeRange {
+	return &r
+}
+
+func (r UnixMilliTimeRange) MarshalYAML() (interface{}, error) {
+	return r.epochRange().marshalYAML()
+}
+
+func (r *UnixMilliTimeRange) UnmarshalYAML(node *yaml.Node) error {
+	return (*epochRange[UnixMilliTime])(r).unmarshalYAML(node)
+}
+
+func (r UnixMilliTimeRange) TimeRange() TimeRange {
+	return r.epochRange().timeRange()
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	stdtime "time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UnixMilliTime is a point in time that marshals to JSON and SQL as epoch milliseconds
+// and to text and YAML as RFC 3339. The zero UnixMilliTime is written as null.
+// Use it instead of UnixTime if milliseconds must survive a JSON round trip.
+// The methods delegate to the implementation shared with the other epoch types.
+type UnixMilliTime stdtime.Time
+
+type UnixMilliTimes []UnixMilliTime
+
+var unixMilliTime = epochType[UnixMilliTime]{
+	name:      "unix milli time",
+	unit:      stdtime.Millisecond,
+	toEpoch:   stdtime.Time.UnixMilli,
+	fromEpoch: stdtime.UnixMilli,
+	of: func(t stdtime.Time) UnixMilliTime {
+		return UnixMilliTime(t)
+	},
+}
+
+var _ encoding.TextMarshaler = UnixMilliTime{}
+
+var _ encoding.TextUnmarshaler = (*UnixMilliTime)(nil)
+
+var _ sql.Scanner = (*UnixMilliTime)(nil)
+
+var _ driver.Valuer = UnixMilliTime{}
+
+var _ yaml.Marshaler = UnixMilliTime{}
+
+var _ yaml.Unmarshaler = (*UnixMilliTime)(nil)
+
+func (t UnixMilliTimes) Interfaces() []interface{} {
+	return epochInterfaces(t)
+}
+
+func (t UnixMilliTimes) Strings() []string {
+	return epochStrings(t)
+}
+
+func UnixMilliTimeFromBinary(ctx context.Context, value []byte) (*UnixMilliTime, error) {
+	return unixMilliTime.fromBinary(ctx, value)
+}
+
+func ParseUnixMilliTimeDefault(
+	ctx context.Context,
+	value interface{},
+	defaultValue UnixMilliTime,
+) UnixMilliTime {
+	return unixMilliTime.parseDefault(ctx, value, defaultValue)
+}
+
+// ParseUnixMilliTime accepts epoch milliseconds and times like ParseTime.
+func ParseUnixMilliTime(ctx context.Context, value interface{}) (*UnixMilliTime, error) {
+	return unixMilliTime.parse(ctx, value)
+}
+
+func UnixMilliTimePtr(time *stdtime.Time) *UnixMilliTime {
+	return unixMilliTime.ptr(time)
+}
+
+func UnixMilliTimeFromMilli(msec int64) UnixMilliTime {
+	return unixMilliTime.at(msec)
+}
+
+// NewUnixMilliTime is time.Date returning a UnixMilliTime.
+func NewUnixMilliTime(
+	year int,
+	month stdtime.Month,
+	day, hour, min, sec, nsec int,
+	loc *stdtime.Location,
+) UnixMilliTime {
+	return UnixMilliTime(stdtime.Date(year, month, day, hour, min, sec, nsec, loc))
+}
+
+func (u UnixMilliTime) Year() int {
+	return u.Time().Year()
+}
+
+func (u UnixMilliTime) Month() stdtime.Month {
+	return u.Time().Month()
+}
+
+func (u UnixMilliTime) Day() int {
+	return u.Time().Day()
+}
+
+func (u UnixMilliTime) Hour() int {
+	return u.Time().Hour()
+}
+
+func (u UnixMilliTime) Minute() int {
+	return u.Time().Minute()
+}
+
+func (u UnixMilliTime) Second() int {
+	return u.Time().Second()
+}
+
+func (u UnixMilliTime) Nanosecond() int {
+	return u.Time().Nanosecond()
+}
+
+func (u UnixMilliTime) Equal(other UnixMilliTime) bool {
+	return u.Time().Equal(other.Time())
+}
+
+func (u *UnixMilliTime) EqualPtr(other *UnixMilliTime) bool {
+	return epochEqualPtr(u, other)
+}
+
+func (u UnixMilliTime) String() string {
+	return u.Format(stdtime.RFC3339Nano)
+}
+
+func (u UnixMilliTime) Validate(ctx context.Context) error {
+	return validateEpochTime(ctx, u)
+}
+
+func (u UnixMilliTime) Ptr() *UnixMilliTime {
+	return &u
+}
+
+func (u UnixMilliTime) Clone() UnixMilliTime {
+	return u
+}
+
+func (u *UnixMilliTime) ClonePtr() *UnixMilliTime {
+	return epochClonePtr(u)
+}
+
+func (u *UnixMilliTime) UnmarshalJSON(b []byte) error {
+	return unixMilliTime.unmarshalJSON(u, b)
+}
+
+func (u UnixMilliTime) MarshalJSON() ([]byte, error) {
+	return unixMilliTime.marshalJSON(u.Time())
+}
+
+func (u UnixMilliTime) MarshalText() ([]byte, error) {
+	return unixMilliTime.marshalText(u.Time())
+}
+
+func (u *UnixMilliTime) UnmarshalText(b []byte) error {
+	return unixMilliTime.unmarshalText(u, b)
+}
+
+func (u UnixMilliTime) MarshalYAML() (interface{}, error) {
+	return marshalYAMLText(u)
+}
+
+func (u *UnixMilliTime) UnmarshalYAML(node *yaml.Node) error {
+	return unixMilliTime.unmarshalYAML(u, node)
+}
+
+func (u *UnixMilliTime) Scan(src interface{}) error {
+	return unixMilliTime.scan(u, src)
+}
+
+func (u UnixMilliTime) Value() (driver.Value, error) {
+	return unixMilliTime.value(u.Time())
+}
+
+func (u UnixMilliTime) Time() stdtime.Time {
+	return stdtime.Time(u)
+}
+
+func (u *UnixMilliTime) TimePtr() *stdtime.Time {
+	return epochTimePtr(u)
+}
+
+func (u UnixMilliTime) Format(layout string) string {
+	return u.Time().Format(layout)
+}
+
+func (u UnixMilliTime) MarshalBinary() ([]byte, error) {
+	return u.Time().MarshalBinary()
+}
+
+func (u UnixMilliTime) Before(time HasTime) bool {
+	return u.Time().Before(time.Time())
+}
+
+func (u UnixMilliTime) After(time HasTime) bool {
+	return u.Time().After(time.Time())
+}
+
+func (u UnixMilliTime) Add(duration HasDuration) UnixMilliTime {
+	return UnixMilliTime(u.Time().Add(duration.Duration()))
+}
+
+func (u UnixMilliTime) Sub(time HasTime) Duration {
+	return Duration(u.Time().Sub(time.Time()))
+}
+
+func (u UnixMilliTime) Unix() int64 {
+	return u.Time().Unix()
+}
+
+func (u UnixMilliTime) UnixMilli() int64 {
+	return u.Time().UnixMilli()
+}
+
+func (u UnixMilliTime) UnixMicro() int64 {
+	return u.Time().UnixMicro()
+}
+
+func (u UnixMilliTime) UnixNano() int64 {
+	return u.Time().UnixNano()
+}
+
+func (u UnixMilliTime) Truncate(duration HasDuration) UnixMilliTime {
+	return UnixMilliTime(u.Time().Truncate(duration.Duration()))
+}
+
+func (u UnixMilliTime) Compare(other UnixMilliTime) int {
+	return Compare(u.Time(), other.Time())
+}
+
+func (u *UnixMilliTime) ComparePtr(other *UnixMilliTime) int {
+	return epochComparePtr(u, other)
+}
+
+func (u UnixMilliTime) DateTime() DateTime {
+	return DateTime(u)
+}
+
+// UnixTime converts to UnixTime, which marshals to JSON as epoch seconds.
+func (u UnixMilliTime) UnixTime() UnixTime {
+	return UnixTime(u)
+}
+
+func (u UnixMilliTime) AddDate(years int, months int, days int) UnixMilliTime {
+	return UnixMilliTime(u.Time().AddDate(years, months, days))
+}
+
+func (u UnixMilliTime) UTC() UnixMilliTime {
+	return UnixMilliTime(u.Time().UTC())
+}
+
+func (u UnixMilliTime) Weekday() Weekday {
+	return Weekday(u.Time().Weekday())
+}
+
+func (u UnixMilliTime) IsZero() bool {
+	return u.Time().IsZero()
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("UnixMilliTime", func() {
+	var ctx context.Context
+	var unixMilliTime libtime.UnixMilliTime
+	BeforeEach(func() {
+		ctx = context.Background()
+		unixMilliTime = libtime.UnixMilliTime(time.Unix(1687161394, 123000000))
+	})
+	It("marshals JSON as epoch milliseconds", func() {
+		bytes, err := json.Marshal(unixMilliTime)
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal(`1687161394123`))
+	})
+	It("unmarshals JSON from epoch milliseconds", func() {
+		var result libtime.UnixMilliTime
+		Expect(json.Unmarshal([]byte(`1687161394123`), &result)).To(Succeed())
+		Expect(result.UTC().String()).To(Equal("2023-06-19T07:56:34.123Z"))
+	})
+	It("keeps milliseconds in a JSON round trip of a struct", func() {
+		type TestStruct struct {
+			Time libtime.UnixMilliTime `json:"time"`
+		}
+		bytes, err := json.Marshal(TestStruct{Time: unixMilliTime})
+		Expect(err).To(BeNil())
+		var result TestStruct
+		Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+		Expect(result.Time.Equal(unixMilliTime)).To(BeTrue())
+	})
+	It("round trips the zero time as JSON null and SQL NULL", func() {
+		bytes, err := json.Marshal(libtime.UnixMilliTime{})
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal(`null`))
+		result := libtime.UnixMilliTime(time.Now())
+		Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+		Expect(result.IsZero()).To(BeTrue())
+		value, err := libtime.UnixMilliTime{}.Value()
+		Expect(err).To(BeNil())
+		Expect(value).To(BeNil())
+		Expect(result.Scan(value)).To(Succeed())
+		Expect(result.IsZero()).To(BeTrue())
+	})
+	It("fails to marshal times outside the range of the unit", func() {
+		outOfRange := libtime.UnixMilliTime(time.Date(300000000, time.January, 1, 0, 0, 0, 0, time.UTC))
+		_, err := json.Marshal(outOfRange)
+		Expect(err).NotTo(BeNil())
+		_, err = outOfRange.Value()
+		Expect(err).NotTo(BeNil())
+	})
+	It("fails to unmarshal invalid JSON", func() {
+		var result libtime.UnixMilliTime
+		Expect(json.Unmarshal([]byte(`"banana"`), &result)).NotTo(Succeed())
+	})
+	It("round trips text", func() {
+		bytes, err := unixMilliTime.MarshalText()
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal("2023-06-19T07:56:34.123Z"))
+		var result libtime.UnixMilliTime
+		Expect(result.UnmarshalText(bytes)).To(Succeed())
+		Expect(result.Equal(unixMilliTime)).To(BeTrue())
+	})
+	It("round trips binary", func() {
+		bytes, err := unixMilliTime.MarshalBinary()
+		Expect(err).To(BeNil())
+		result, err := libtime.UnixMilliTimeFromBinary(ctx, bytes)
+		Expect(err).To(BeNil())
+		Expect(result.Equal(unixMilliTime)).To(BeTrue())
+	})
+	DescribeTable("ParseUnixMilliTime",
+		func(input any, expected string, expectedError bool) {
+			result, err := libtime.ParseUnixMilliTime(ctx, input)
+			if expectedError {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+				Expect(result).NotTo(BeNil())
+				Expect(result.UTC().String()).To(Equal(expected))
+			}
+		},
+		Entry("invalid", "banana", "", true),
+		Entry("dateTime", "2023-06-19T07:56:34.123Z", "2023-06-19T07:56:34.123Z", false),
+		Entry("number", int64(1687161394123), "2023-06-19T07:56:34.123Z", false),
+		Entry("string", "1687161394123", "2023-06-19T07:56:34.123Z", false),
+	)
+	It("returns the default on parse error", func() {
+		result := libtime.ParseUnixMilliTimeDefault(ctx, "banana", unixMilliTime)
+		Expect(result.Equal(unixMilliTime)).To(BeTrue())
+	})
+	It("validates", func() {
+		Expect(unixMilliTime.Validate(ctx)).To(BeNil())
+		Expect(libtime.UnixMilliTime{}.Validate(ctx)).NotTo(BeNil())
+	})
+	It("converts from and to UnixTime", func() {
+		Expect(unixMilliTime.UnixTime().UnixMilliTime().Equal(unixMilliTime)).To(BeTrue())
+		Expect(unixMilliTime.UnixMilli()).To(Equal(int64(1687161394123)))
+		Expect(unixMilliTime.Unix()).To(Equal(int64(1687161394)))
+	})
+	It("creates from epoch milliseconds", func() {
+		Expect(libtime.UnixMilliTimeFromMilli(1687161394123).Equal(unixMilliTime)).To(BeTrue())
+	})
+})
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	stdtime "time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UnixNanoTimeRange is a range of UnixNanoTime. Ranges of the epoch types share their implementation.
+type UnixNanoTimeRange struct {
+	From  UnixNanoTime `json:"from,omitempty"`
+	Until UnixNanoTime `json:"until,omitempty"`
+}
+
+type UnixNanoTimeRanges []UnixNanoTimeRange
+
+var _ yaml.Marshaler = UnixNanoTimeRange{}
+
+var _ yaml.Unmarshaler = (*UnixNanoTimeRange)(nil)
+
+// Max returns the range from the earliest From to the latest Until or nil if ranges is empty.
+func (ranges UnixNanoTimeRanges) Max() *UnixNanoTimeRange {
+	return (*UnixNanoTimeRange)(maxEpochRange(ranges, UnixNanoTimeRange.epochRange))
+}
+
+// Min returns the range from the latest From to the earliest Until
+// or nil if ranges is empty or the ranges do not overlap.
+func (ranges UnixNanoTimeRanges) Min() *UnixNanoTimeRange {
+	return (*UnixNanoTimeRange)(minEpochRange(ranges, UnixNanoTimeRange.epochRange))
+}
+
+func UnixNanoTimeRangeFromTime(from, until stdtime.Time) UnixNanoTimeRange {
+	return UnixNanoTimeRange{From: UnixNanoTime(from), Until: UnixNanoTime(until)}
+}
+
+// DayUnixNanoTimeRange returns the day containing ut like DayDateTimeRange.
+func DayUnixNanoTimeRange(ut UnixNanoTime) UnixNanoTimeRange {
+	return UnixNanoTimeRange(unixNanoTime.calendarRange(ut, BeginningOfDay, EndOfDay))
+}
+
+// WeekUnixNanoTimeRange returns the ISO week (Monday to Sunday) containing ut.
+func WeekUnixNanoTimeRange(ut UnixNanoTime) UnixNanoTimeRange {
+	return UnixNanoTimeRange(unixNanoTime.calendarRange(ut, BeginningOfWeek, EndOfWeek))
+}
+
+func MonthUnixNanoTimeRange(ut UnixNanoTime) UnixNanoTimeRange {
+	return UnixNanoTimeRange(unixNanoTime.calendarRange(ut, BeginningOfMonth, EndOfMonth))
+}
+
+func QuarterUnixNanoTimeRange(ut UnixNanoTime) UnixNanoTimeRange {
+	return UnixNanoTimeRange(unixNanoTime.calendarRange(ut, BeginningOfQuarter, EndOfQuarter))
+}
+
+func YearUnixNanoTimeRange(ut UnixNanoTime) UnixNanoTimeRange {
+	return UnixNanoTimeRange(unixNanoTime.calendarRange(ut, BeginningOfYear, EndOfYear))
+}
+
+func (r UnixNanoTimeRange) epochRange() epochRange[UnixNanoTime] {
+	return epochRange[UnixNanoTime](r)
+}
+
+func (r UnixNanoTimeRange) Validate(ctx context.Context) error {
+	return r.epochRange().validate(ctx)
+}
+
+func (r UnixNanoTimeRange) Ptr() *UnixNanoTimeRange {
+	return &r
+}
+
+func (r UnixNanoTimeRange) MarshalYAML() (interface{}, error) {
+	return r.epochRange().marshalYAML()
+}
+
+func (r *UnixNanoTimeRange) UnmarshalYAML(node *yaml.Node) error {
+	return (*epochRange[UnixNanoTime])(r).unmarshalYAML(node)
+}
+
+func (r UnixNanoTimeRange) TimeRange() TimeRange {
+	return r.epochRange().timeRange()
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	stdtime "time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UnixNanoTime is a point in time that marshals to JSON and SQL as epoch nanoseconds
+// and to text and YAML as RFC 3339. The zero UnixNanoTime is written as null.
+// Use it instead of UnixTime if nanoseconds must survive a JSON round trip.
+// The methods delegate to the implementation shared with the other epoch types.
+type UnixNanoTime stdtime.Time
+
+type UnixNanoTimes []UnixNanoTime
+
+var unixNanoTime = epochType[UnixNanoTime]{
+	name:      "unix nano time",
+	unit:      stdtime.Nanosecond,
+	toEpoch:   stdtime.Time.UnixNano,
+	fromEpoch: unixNanoTimeFromEpoch,
+	of: func(t stdtime.Time) UnixNanoTime {
+		return UnixNanoTime(t)
+	},
+}
+
+func unixNanoTimeFromEpoch(nsec int64) stdtime.Time {
+	return stdtime.Unix(0, nsec)
+}
+
+var _ encoding.TextMarshaler = UnixNanoTime{}
+
+var _ encoding.TextUnmarshaler = (*UnixNanoTime)(nil)
+
+var _ sql.Scanner = (*UnixNanoTime)(nil)
+
+var _ driver.Valuer = UnixNanoTime{}
+
+var _ yaml.Marshaler = UnixNanoTime{}
+
+var _ yaml.Unmarshaler = (*UnixNanoTime)(nil)
+
+func (t UnixNanoTimes) Interfaces() []interface{} {
+	return epochInterfaces(t)
+}
+
+func (t UnixNanoTimes) Strings() []string {
+	return epochStrings(t)
+}
+
+func UnixNanoTimeFromBinary(ctx context.Context, value []byte) (*UnixNanoTime, error) {
+	return unixNanoTime.fromBinary(ctx, value)
+}
+
+func ParseUnixNanoTimeDefault(
+	ctx context.Context,
+	value interface{},
+	defaultValue UnixNanoTime,
+) UnixNanoTime {
+	return unixNanoTime.parseDefault(ctx, value, defaultValue)
+}
+
+// ParseUnixNanoTime accepts epoch nanoseconds and times like ParseTime.
+func ParseUnixNanoTime(ctx context.Context, value interface{}) (*UnixNanoTime, error) {
+	return unixNanoTime.parse(ctx, value)
+}
+
+func UnixNanoTimePtr(time *stdtime.Time) *UnixNanoTime {
+	return unixNanoTime.ptr(time)
+}
+
+func UnixNanoTimeFromNano(nsec int64) UnixNanoTime {
+	return unixNanoTime.at(nsec)
+}
+
+// NewUnixNanoTime is time.Date returning a UnixNanoTime.
+func NewUnixNanoTime(
+	year int,
+	month stdtime.Month,
+	day, hour, min, sec, nsec int,
+	loc *stdtime.Location,
+) UnixNanoTime {
+	return UnixNanoTime(stdtime.Date(year, month, day, hour, min, sec, nsec, loc))
+}
+
+func (u UnixNanoTime) Year() int {
+	return u.Time().Year()
+}
+
+func (u UnixNanoTime) Month() stdtime.Month {
+	return u.Time().Month()
+}
+
+func (u UnixNanoTime) Day() int {
+	return u.Time().Day()
+}
+
+func (u UnixNanoTime) Hour() int {
+	return u.Time().Hour()
+}
+
+func (u UnixNanoTime) Minute() int {
+	return u.Time().Minute()
+}
+
+func (u UnixNanoTime) Second() int {
+	return u.Time().Second()
+}
+
+func (u UnixNanoTime) Nanosecond() int {
+	return u.Time().Nanosecond()
+}
+
+func (u UnixNanoTime) Equal(other UnixNanoTime) bool {
+	return u.Time().Equal(other.Time())
+}
+
+func (u *UnixNanoTime) EqualPtr(other *UnixNanoTime) bool {
+	return epochEqualPtr(u, other)
+}
+
+func (u UnixNanoTime) String() string {
+	return u.Format(stdtime.RFC3339Nano)
+}
+
+func (u UnixNanoTime) Validate(ctx context.Context) error {
+	return validateEpochTime(ctx, u)
+}
+
+func (u UnixNanoTime) Ptr() *UnixNanoTime {
+	return &u
+}
+
+func (u UnixNanoTime) Clone() UnixNanoTime {
+	return u
+}
+
+func (u *UnixNanoTime) ClonePtr() *UnixNanoTime {
+	return epochClonePtr(u)
+}
+
+func (u *UnixNanoTime) UnmarshalJSON(b []byte) error {
+	return unixNanoTime.unmarshalJSON(u, b)
+}
+
+func (u UnixNanoTime) MarshalJSON() ([]byte, error) {
+	return unixNanoTime.marshalJSON(u.Time())
+}
+
+func (u UnixNanoTime) MarshalText() ([]byte, error) {
+	return unixNanoTime.marshalText(u.Time())
+}
+
+func (u *UnixNanoTime) UnmarshalText(b []byte) error {
+	return unixNanoTime.unmarshalText(u, b)
+}
+
+func (u UnixNanoTime) MarshalYAML() (interface{}, error) {
+	return marshalYAMLText(u)
+}
+
+func (u *UnixNanoTime) UnmarshalYAML(node *yaml.Node) error {
+	return unixNanoTime.unmarshalYAML(u, node)
+}
+
+func (u *UnixNanoTime) Scan(src interface{}) error {
+	return unixNanoTime.scan(u, src)
+}
+
+func (u UnixNanoTime) Value() (driver.Value, error) {
+	return unixNanoTime.value(u.Time())
+}
+
+func (u UnixNanoTime) Time() stdtime.Time {
+	return stdtime.Time(u)
+}
+
+func (u *UnixNanoTime) TimePtr() *stdtime.Time {
+	return epochTimePtr(u)
+}
+
+func (u UnixNanoTime) Format(layout string) string {
+	return u.Time().Format(layout)
+}
+
+func (u UnixNanoTime) MarshalBinary() ([]byte, error) {
+	return u.Time().MarshalBinary()
+}
+
+func (u UnixNanoTime) Before(time HasTime) bool {
+	return u.Time().Before(time.Time())
+}
+
+func (u UnixNanoTime) After(time HasTime) bool {
+	return u.Time().After(time.Time())
+}
+
+func (u UnixNanoTime) Add(duration HasDuration) UnixNanoTime {
+	return UnixNanoTime(u.Time().Add(duration.Duration()))
+}
+
+func (u UnixNanoTime) Sub(time HasTime) Duration {
+	return Duration(u.Time().Sub(time.Time()))
+}
+
+func (u UnixNanoTime) Unix() int64 {
+	return u.Time().Unix()
+}
+
+func (u UnixNanoTime) UnixMilli() int64 {
+	return u.Time().UnixMilli()
+}
+
+func (u UnixNanoTime) UnixMicro() int64 {
+	return u.Time().UnixMicro()
+}
+
+func (u UnixNanoTime) UnixNano() int64 {
+	return u.Time().UnixNano()
+}
+
+func (u UnixNanoTime) Truncate(duration HasDuration) UnixNanoTime {
+	return UnixNanoTime(u.Time().Truncate(duration.Duration()))
+}
+
+func (u UnixNanoTime) Compare(other UnixNanoTime) int {
+	return Compare(u.Time(), other.Time())
+}
+
+func (u *UnixNanoTime) ComparePtr(other *UnixNanoTime) int {
+	return epochComparePtr(u, other)
+}
+
+func (u UnixNanoTime) DateTime() DateTime {
+	return DateTime(u)
+}
+
+// UnixTime converts to UnixTime, which marshals to JSON as epoch seconds.
+func (u UnixNanoTime) UnixTime() UnixTime {
+	return UnixTime(u)
+}
+
+func (u UnixNanoTime) AddDate(years int, months int, days int) UnixNanoTime {
+	return UnixNanoTime(u.Time().AddDate(years, months, days))
+}
+
+func (u UnixNanoTime) UTC() UnixNanoTime {
+	return UnixNanoTime(u.Time().UTC())
+}
+
+func (u UnixNanoTime) Weekday() Weekday {
+	return Weekday(u.Time().Weekday())
+}
+
+func (u UnixNanoTime) IsZero() bool {
+	return u.Time().IsZero()
+}
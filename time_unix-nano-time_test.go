@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("UnixNanoTime", func() {
+	var ctx context.Context
+	var unixNanoTime libtime.UnixNanoTime
+	BeforeEach(func() {
+		ctx = context.Background()
+		unixNanoTime = libtime.UnixNanoTime(time.Unix(1687161394, 123456789))
+	})
+	It("marshals JSON as epoch nanoseconds", func() {
+		bytes, err := json.Marshal(unixNanoTime)
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal(`1687161394123456789`))
+	})
+	It("unmarshals JSON from epoch nanoseconds", func() {
+		var result libtime.UnixNanoTime
+		Expect(json.Unmarshal([]byte(`1687161394123456789`), &result)).To(Succeed())
+		Expect(result.UTC().String()).To(Equal("2023-06-19T07:56:34.123456789Z"))
+	})
+	It("keeps nanoseconds in a JSON round trip of a struct", func() {
+		type TestStruct struct {
+			Time libtime.UnixNanoTime `json:"time"`
+		}
+		bytes, err := json.Marshal(TestStruct{Time: unixNanoTime})
+		Expect(err).To(BeNil())
+		var result TestStruct
+		Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+		Expect(result.Time.Equal(unixNanoTime)).To(BeTrue())
+	})
+	It("round trips the zero time as JSON null and SQL NULL", func() {
+		bytes, err := json.Marshal(libtime.UnixNanoTime{})
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal(`null`))
+		result := libtime.UnixNanoTime(time.Now())
+		Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+		Expect(result.IsZero()).To(BeTrue())
+		value, err := libtime.UnixNanoTime{}.Value()
+		Expect(err).To(BeNil())
+		Expect(value).To(BeNil())
+		Expect(result.Scan(value)).To(Succeed())
+		Expect(result.IsZero()).To(BeTrue())
+	})
+	It("fails to marshal times outside the range of the unit", func() {
+		outOfRange := libtime.UnixNanoTime(time.Date(2300, time.January, 1, 0, 0, 0, 0, time.UTC))
+		_, err := json.Marshal(outOfRange)
+		Expect(err).NotTo(BeNil())
+		_, err = outOfRange.Value()
+		Expect(err).NotTo(BeNil())
+	})
+	It("fails to unmarshal invalid JSON", func() {
+		var result libtime.UnixNanoTime
+		Expect(json.Unmarshal([]byte(`"banana"`), &result)).NotTo(Succeed())
+	})
+	It("round trips text", func() {
+		bytes, err := unixNanoTime.MarshalText()
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal("2023-06-19T07:56:34.123456789Z"))
+		var result libtime.UnixNanoTime
+		Expect(result.UnmarshalText(bytes)).To(Succeed())
+		Expect(result.Equal(unixNanoTime)).To(BeTrue())
+	})
+	It("round trips binary", func() {
+		bytes, err := unixNanoTime.MarshalBinary()
+		Expect(err).To(BeNil())
+		result, err := libtime.UnixNanoTimeFromBinary(ctx, bytes)
+		Expect(err).To(BeNil())
+		Expect(result.Equal(unixNanoTime)).To(BeTrue())
+	})
+	DescribeTable("ParseUnixNanoTime",
+		func(input any, expected string, expectedError bool) {
+			result, err := libtime.ParseUnixNanoTime(ctx, input)
+			if expectedError {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+				Expect(result).NotTo(BeNil())
+				Expect(result.UTC().String()).To(Equal(expected))
+			}
+		},
+		Entry("invalid", "banana", "", true),
+		Entry("dateTime", "2023-06-19T07:56:34.123456789Z", "2023-06-19T07:56:34.123456789Z", false),
+		Entry("number", int64(1687161394123456789), "2023-06-19T07:56:34.123456789Z", false),
+		Entry("string", "1687161394123456789", "2023-06-19T07:56:34.123456789Z", false),
+	)
+	It("returns the default on parse error", func() {
+		result := libtime.ParseUnixNanoTimeDefault(ctx, "banana", unixNanoTime)
+		Expect(result.Equal(unixNanoTime)).To(BeTrue())
+	})
+	It("validates", func() {
+		Expect(unixNanoTime.Validate(ctx)).To(BeNil())
+		Expect(libtime.UnixNanoTime{}.Validate(ctx)).NotTo(BeNil())
+	})
+	It("converts from and to UnixTime", func() {
+		Expect(unixNanoTime.UnixTime().UnixNanoTime().Equal(unixNanoTime)).To(BeTrue())
+		Expect(unixNanoTime.UnixNano()).To(Equal(int64(1687161394123456789)))
+		Expect(unixNanoTime.Unix()).To(Equal(int64(1687161394)))
+	})
+	It("creates from epoch nanoseconds", func() {
+		Expect(libtime.UnixNanoTimeFromNano(1687161394123456789).Equal(unixNanoTime)).To(BeTrue())
+	})
+})
@@ -10,6 +10,7 @@ import (
 
 	"github.com/bborbe/errors"
 	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
 )
 
 type UnixTimeRanges []UnixTimeRange
@@ -70,6 +71,10 @@ func UnixTimeRangeFromTime(from, until stdtime.Time) UnixTimeRange {
 	}
 }
 
+var _ yaml.Marshaler = UnixTimeRange{}
+
+var _ yaml.Unmarshaler = (*UnixTimeRange)(nil)
+
 type UnixTimeRange struct {
 	From  UnixTime `json:"from,omitempty"`
 	Until UnixTime `json:"until,omitempty"`
@@ -96,6 +101,22 @@ func (r UnixTimeRange) Ptr() *UnixTimeRange {
 	return &r
 }
 
+// MarshalYAML implements yaml.Marshaler and writes from and until. Zero sides are omitted.
+func (r UnixTimeRange) MarshalYAML() (interface{}, error) {
+	return yamlRange[UnixTime]{From: r.From, Until: r.Until}, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. From and until accept the same values
+// as UnixTime.UnmarshalYAML.
+func (r *UnixTimeRange) UnmarshalYAML(node *yaml.Node) error {
+	result, err := unmarshalYAMLRange[UnixTime](node)
+	if err != nil {
+		return errors.Wrapf(context.Background(), err, "unmarshal UnixTimeRange failed")
+	}
+	*r = UnixTimeRange{From: result.From, Until: result.Until}
+	return nil
+}
+
 // DayUnixTimeRange creates a UnixTimeRange covering the entire day containing the given unix time.
 // The range spans from 00:00:00.000000000 to 23:59:59.999999999 of that day.
 func DayUnixTimeRange(ut UnixTime) UnixTimeRange {
@@ -6,6 +6,8 @@ package time
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"encoding"
 	"encoding/json"
 	"strconv"
@@ -15,6 +17,7 @@ import (
 	"github.com/bborbe/errors"
 	"github.com/bborbe/parse"
 	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
 )
 
 type UnixTimes []UnixTime
@@ -88,6 +91,8 @@ func UnixTimeFromMicro(usec int64) UnixTime {
 
 // NewUnixTime creates a UnixTime representing the date and time specified by the given parameters.
 // It wraps the standard library's time.Date function with the same parameter signature.
+// Ambiguous and nonexistent local times are resolved like time.Date, use NewUnixTimeWithResolver
+// to control it.
 func NewUnixTime(
 	year int,
 	month stdtime.Month,
@@ -103,6 +108,14 @@ var _ encoding.TextMarshaler = UnixTime{}
 
 var _ encoding.TextUnmarshaler = (*UnixTime)(nil)
 
+var _ sql.Scanner = (*UnixTime)(nil)
+
+var _ driver.Valuer = UnixTime{}
+
+var _ yaml.Marshaler = UnixTime{}
+
+var _ yaml.Unmarshaler = (*UnixTime)(nil)
+
 func (u UnixTime) Year() int {
 	return u.Time().Year()
 }
@@ -207,6 +220,62 @@ func (u *UnixTime) UnmarshalText(b []byte) error {
 	return nil
 }
 
+// MarshalYAML implements yaml.Marshaler like MarshalText. The zero UnixTime is written as null.
+func (u UnixTime) MarshalYAML() (interface{}, error) {
+	return marshalYAMLText(u)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It accepts epoch seconds and times
+// like ParseUnixTime. Empty values and "null" result in the zero UnixTime.
+func (u *UnixTime) UnmarshalYAML(node *yaml.Node) error {
+	ctx := context.Background()
+	str, err := yamlScalar(ctx, node)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "decode yaml failed")
+	}
+	if str == "" {
+		*u = UnixTime{}
+		return nil
+	}
+	result, err := ParseUnixTime(ctx, str)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "parse '%s' at line %d failed", str, node.Line)
+	}
+	*u = *result
+	return nil
+}
+
+// Scan implements sql.Scanner for integer epoch seconds and timestamp columns.
+// NULL scans into the zero UnixTime.
+func (u *UnixTime) Scan(src interface{}) error {
+	ctx := context.Background()
+	switch v := src.(type) {
+	case nil:
+		*u = UnixTime{}
+		return nil
+	case stdtime.Time:
+		*u = UnixTime(v)
+		return nil
+	case []byte:
+		src = string(v)
+	}
+	result, err := ParseUnixTime(ctx, src)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "scan unix time failed")
+	}
+	*u = *result
+	return nil
+}
+
+// Value implements driver.Valuer and returns epoch seconds.
+// The zero UnixTime is stored as NULL.
+func (u UnixTime) Value() (driver.Value, error) {
+	if u.IsZero() {
+		return nil, nil
+	}
+	return u.Unix(), nil
+}
+
 func (u UnixTime) Time() stdtime.Time {
 	return stdtime.Time(u)
 }
@@ -276,6 +345,21 @@ func (u UnixTime) DateTime() DateTime {
 	return DateTime(u)
 }
 
+// UnixMilliTime converts to UnixMilliTime, which marshals to JSON as epoch milliseconds.
+func (u UnixTime) UnixMilliTime() UnixMilliTime {
+	return UnixMilliTime(u)
+}
+
+// UnixMicroTime converts to UnixMicroTime, which marshals to JSON as epoch microseconds.
+func (u UnixTime) UnixMicroTime() UnixMicroTime {
+	return UnixMicroTime(u)
+}
+
+// UnixNanoTime converts to UnixNanoTime, which marshals to JSON as epoch nanoseconds.
+func (u UnixTime) UnixNanoTime() UnixNanoTime {
+	return UnixNanoTime(u)
+}
+
 func (u UnixTime) AddDate(years int, months int, days int) UnixTime {
 	return UnixTime(u.Time().AddDate(years, months, days))
 }
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+
+	"github.com/bborbe/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// WeekdayName is a Weekday that marshals to JSON, text and YAML as its English name
+// like "Monday" instead of a number. It unmarshals the same values as Weekday.
+type WeekdayName Weekday
+
+var _ encoding.TextMarshaler = WeekdayName(0)
+
+var _ encoding.TextUnmarshaler = (*WeekdayName)(nil)
+
+var _ json.Marshaler = WeekdayName(0)
+
+var _ json.Unmarshaler = (*WeekdayName)(nil)
+
+var _ yaml.Marshaler = WeekdayName(0)
+
+var _ yaml.Unmarshaler = (*WeekdayName)(nil)
+
+func (w WeekdayName) Validate(ctx context.Context) error {
+	return w.Weekday().Validate(ctx)
+}
+
+func (w WeekdayName) String() string {
+	return w.Weekday().String()
+}
+
+func (w WeekdayName) Weekday() Weekday {
+	return Weekday(w)
+}
+
+func (w WeekdayName) Ptr() *WeekdayName {
+	return &w
+}
+
+// MarshalText writes the English name like "Monday". Invalid weekdays return an error.
+func (w WeekdayName) MarshalText() ([]byte, error) {
+	if err := w.Validate(context.Background()); err != nil {
+		return nil, errors.Wrapf(context.Background(), err, "marshal weekday %d failed", int(w))
+	}
+	return []byte(w.String()), nil
+}
+
+// UnmarshalText accepts the same values as ParseWeekday.
+// Empty text results in Sunday, the zero Weekday.
+func (w *WeekdayName) UnmarshalText(b []byte) error {
+	return (*Weekday)(w).UnmarshalText(b)
+}
+
+// MarshalJSON writes the English name like "Monday".
+func (w WeekdayName) MarshalJSON() ([]byte, error) {
+	text, err := w.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON accepts names and numbers like Weekday. Null results in Sunday.
+func (w *WeekdayName) UnmarshalJSON(b []byte) error {
+	return (*Weekday)(w).UnmarshalJSON(b)
+}
+
+// MarshalYAML implements yaml.Marshaler and writes the English name like MarshalJSON.
+func (w WeekdayName) MarshalYAML() (interface{}, error) {
+	text, err := w.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It accepts the same values as Weekday.
+func (w *WeekdayName) UnmarshalYAML(node *yaml.Node) error {
+	return (*Weekday)(w).UnmarshalYAML(node)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("WeekdayName", func() {
+	type TestStruct struct {
+		Value libtime.WeekdayName `json:"value" yaml:"value"`
+	}
+	It("marshals json as name", func() {
+		bytes, err := json.Marshal(TestStruct{Value: libtime.WeekdayName(libtime.Monday)})
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal(`{"value":"Monday"}`))
+		var result TestStruct
+		Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+		Expect(result.Value.Weekday()).To(Equal(libtime.Monday))
+	})
+	It("unmarshals numbers", func() {
+		var result TestStruct
+		Expect(json.Unmarshal([]byte(`{"value":5}`), &result)).To(Succeed())
+		Expect(result.Value.Weekday()).To(Equal(libtime.Friday))
+	})
+	It("marshals yaml as name", func() {
+		bytes, err := yaml.Marshal(TestStruct{Value: libtime.WeekdayName(libtime.Wednesday)})
+		Expect(err).To(BeNil())
+		Expect(string(bytes)).To(Equal("value: Wednesday\n"))
+		var result TestStruct
+		Expect(yaml.Unmarshal(bytes, &result)).To(Succeed())
+		Expect(result.Value.Weekday()).To(Equal(libtime.Wednesday))
+	})
+	It("round trips text", func() {
+		text, err := libtime.WeekdayName(libtime.Friday).MarshalText()
+		Expect(err).To(BeNil())
+		Expect(string(text)).To(Equal("Friday"))
+		var result libtime.WeekdayName
+		Expect(result.UnmarshalText([]byte("fr"))).To(Succeed())
+		Expect(result.Weekday()).To(Equal(libtime.Friday))
+	})
+	It("fails to marshal invalid weekdays", func() {
+		_, err := json.Marshal(libtime.WeekdayName(1337))
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,266 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"math/bits"
+	"strings"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// WeekdaySetWorkdays contains Monday to Friday.
+	WeekdaySetWorkdays = WeekdaySet(1<<Monday | 1<<Tuesday | 1<<Wednesday | 1<<Thursday | 1<<Friday)
+	// WeekdaySetWeekend contains Saturday and Sunday.
+	WeekdaySetWeekend = WeekdaySet(1<<Saturday | 1<<Sunday)
+	// WeekdaySetAll contains all weekdays.
+	WeekdaySetAll = WeekdaySetWorkdays | WeekdaySetWeekend
+)
+
+// NewWeekdaySet returns a WeekdaySet containing the given weekdays.
+func NewWeekdaySet(weekdays ...Weekday) WeekdaySet {
+	return WeekdaySet(0).Add(weekdays...)
+}
+
+// ParseWeekdaySet parses the same values as ParseWeekdays, including ranges like "Mon-Fri".
+func ParseWeekdaySet(ctx context.Context, value any) (*WeekdaySet, error) {
+	weekdays, err := ParseWeekdays(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse weekdays failed")
+	}
+	if err := weekdays.Validate(ctx); err != nil {
+		return nil, errors.Wrapf(ctx, err, "validate weekdays failed")
+	}
+	return weekdays.WeekdaySet().Ptr(), nil
+}
+
+// WeekdaySet is a set of weekdays stored as bitmask, bit 0 is Sunday.
+// All operations return a new set and leave the receiver unchanged.
+type WeekdaySet uint8
+
+var _ json.Marshaler = WeekdaySet(0)
+
+var _ json.Unmarshaler = (*WeekdaySet)(nil)
+
+var _ yaml.Marshaler = WeekdaySet(0)
+
+var _ yaml.Unmarshaler = (*WeekdaySet)(nil)
+
+// WeekdaySet converts the weekdays into a set. Invalid weekdays are ignored.
+func (w Weekdays) WeekdaySet() WeekdaySet {
+	return NewWeekdaySet(w...)
+}
+
+// Add returns the set with the given weekdays added. Invalid weekdays are ignored.
+func (s WeekdaySet) Add(weekdays ...Weekday) WeekdaySet {
+	for _, weekday := range weekdays {
+		s |= weekdaySetBit(weekday)
+	}
+	return s
+}
+
+// Remove returns the set without the given weekdays.
+func (s WeekdaySet) Remove(weekdays ...Weekday) WeekdaySet {
+	for _, weekday := range weekdays {
+		s &^= weekdaySetBit(weekday)
+	}
+	return s
+}
+
+// Union returns all weekdays contained in s or other.
+func (s WeekdaySet) Union(other WeekdaySet) WeekdaySet {
+	return s | other
+}
+
+// Intersect returns all weekdays contained in s and other.
+func (s WeekdaySet) Intersect(other WeekdaySet) WeekdaySet {
+	return s & other
+}
+
+// Complement returns all weekdays not contained in s.
+func (s WeekdaySet) Complement() WeekdaySet {
+	return ^s & WeekdaySetAll
+}
+
+// Contains reports whether weekday is in the set.
+func (s WeekdaySet) Contains(weekday Weekday) bool {
+	return s&weekdaySetBit(weekday) != 0
+}
+
+// Len returns the number of weekdays in the set.
+func (s WeekdaySet) Len() int {
+	return bits.OnesCount8(uint8(s & WeekdaySetAll))
+}
+
+// IsEmpty reports whether the set contains no weekday.
+func (s WeekdaySet) IsEmpty() bool {
+	return s&WeekdaySetAll == 0
+}
+
+// All iterates the weekdays of the set in order, starting with first.
+// For example All(Monday) yields Sunday last.
+func (s WeekdaySet) All(first Weekday) iter.Seq[Weekday] {
+	return func(yield func(Weekday) bool) {
+		start := ((int(first) % 7) + 7) % 7
+		for i := 0; i < 7; i++ {
+			weekday := Weekday((start + i) % 7)
+			if s.Contains(weekday) && !yield(weekday) {
+				return
+			}
+		}
+	}
+}
+
+// Weekdays returns the weekdays of the set starting with Sunday.
+func (s WeekdaySet) Weekdays() Weekdays {
+	return s.WeekdaysFrom(Sunday)
+}
+
+// WeekdaysFrom returns the weekdays of the set in order, starting with first.
+func (s WeekdaySet) WeekdaysFrom(first Weekday) Weekdays {
+	result := make(Weekdays, 0, s.Len())
+	for weekday := range s.All(first) {
+		result = append(result, weekday)
+	}
+	return result
+}
+
+// NextMatching returns the first date on or after date with a weekday in the set.
+// It returns false if the set is empty.
+func (s WeekdaySet) NextMatching(date Date) (Date, bool) {
+	return s.matching(date, 1)
+}
+
+// PrevMatching returns the last date on or before date with a weekday in the set.
+// It returns false if the set is empty.
+func (s WeekdaySet) PrevMatching(date Date) (Date, bool) {
+	return s.matching(date, -1)
+}
+
+func (s WeekdaySet) matching(date Date, step int) (Date, bool) {
+	if s.IsEmpty() {
+		return Date{}, false
+	}
+	weekday := int(date.Weekday())
+	for days := 0; days < 7; days++ {
+		if s.Contains(Weekday(((weekday+step*days)%7 + 7) % 7)) {
+			return date.AddDate(0, 0, step*days), true
+		}
+	}
+	return Date{}, false
+}
+
+// String returns the weekday names separated by comma, like "Monday,Friday".
+func (s WeekdaySet) String() string {
+	weekdays := s.Weekdays()
+	names := make([]string, len(weekdays))
+	for i, weekday := range weekdays {
+		names[i] = weekday.String()
+	}
+	return strings.Join(names, ",")
+}
+
+func (s WeekdaySet) Validate(ctx context.Context) error {
+	if s&^WeekdaySetAll != 0 {
+		return errors.Wrapf(ctx, validation.Error, "WeekdaySet contains invalid bits")
+	}
+	return nil
+}
+
+func (s WeekdaySet) Ptr() *WeekdaySet {
+	return &s
+}
+
+// MarshalJSON writes the weekdays as numbers like Weekdays, e.g. [1,5] for Monday and Friday.
+// Marshal Names to write names.
+func (s WeekdaySet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Weekdays())
+}
+
+// UnmarshalJSON accepts an array like Weekdays or a string like "Mon-Fri".
+// Null results in the empty set.
+func (s *WeekdaySet) UnmarshalJSON(b []byte) error {
+	ctx := context.Background()
+	if string(b) == "null" {
+		*s = 0
+		return nil
+	}
+	var value any
+	if len(b) > 0 && b[0] == '"' {
+		var str string
+		if err := json.Unmarshal(b, &str); err != nil {
+			return errors.Wrapf(ctx, err, "unmarshal string failed")
+		}
+		value = str
+	} else {
+		var weekdays Weekdays
+		if err := json.Unmarshal(b, &weekdays); err != nil {
+			return errors.Wrapf(ctx, err, "unmarshal weekdays failed")
+		}
+		value = weekdays
+	}
+	result, err := ParseWeekdaySet(ctx, value)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "parse weekday set failed")
+	}
+	*s = *result
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler and writes the weekdays as sequence of numbers
+// like MarshalJSON.
+func (s WeekdaySet) MarshalYAML() (interface{}, error) {
+	return s.Weekdays(), nil
+}
+
+// Names returns the weekdays of s as WeekdayName, which marshal as names like "Monday".
+func (s WeekdaySet) Names() []WeekdayName {
+	weekdays := s.Weekdays()
+	result := make([]WeekdayName, len(weekdays))
+	for i, weekday := range weekdays {
+		result[i] = WeekdayName(weekday)
+	}
+	return result
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It accepts a sequence like Weekdays
+// or a scalar like "Mon-Fri".
+func (s *WeekdaySet) UnmarshalYAML(node *yaml.Node) error {
+	ctx := context.Background()
+	var value any
+	if node.Kind == yaml.SequenceNode {
+		var weekdays Weekdays
+		if err := node.Decode(&weekdays); err != nil {
+			return errors.Wrapf(ctx, err, "decode weekdays failed")
+		}
+		value = weekdays
+	} else {
+		str, err := yamlScalar(ctx, node)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "decode yaml failed")
+		}
+		value = str
+	}
+	result, err := ParseWeekdaySet(ctx, value)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "parse weekday set at line %d failed", node.Line)
+	}
+	*s = *result
+	return nil
+}
+
+// weekdaySetBit returns the bit of weekday or 0 for invalid weekdays.
+func weekdaySetBit(weekday Weekday) WeekdaySet {
+	if weekday < Sunday || weekday > Saturday {
+		return 0
+	}
+	return 1 << weekday
+}
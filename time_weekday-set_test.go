@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("WeekdaySet", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	It("adds and removes weekdays", func() {
+		set := libtime.NewWeekdaySet(libtime.Monday, libtime.Monday, libtime.Friday)
+		Expect(set.Len()).To(Equal(2))
+		Expect(set.Contains(libtime.Monday)).To(BeTrue())
+		Expect(set.Contains(libtime.Tuesday)).To(BeFalse())
+		Expect(set.Contains(libtime.Weekday(1337))).To(BeFalse())
+		set = set.Add(libtime.Sunday).Remove(libtime.Monday)
+		Expect(set.Weekdays()).To(Equal(libtime.Weekdays{libtime.Sunday, libtime.Friday}))
+		Expect(libtime.NewWeekdaySet(libtime.Weekday(9)).IsEmpty()).To(BeTrue())
+	})
+	It("supports set operations", func() {
+		a := libtime.NewWeekdaySet(libtime.Monday, libtime.Tuesday)
+		b := libtime.NewWeekdaySet(libtime.Tuesday, libtime.Saturday)
+		Expect(a.Union(b)).To(Equal(
+			libtime.NewWeekdaySet(libtime.Monday, libtime.Tuesday, libtime.Saturday),
+		))
+		Expect(a.Intersect(b)).To(Equal(libtime.NewWeekdaySet(libtime.Tuesday)))
+		Expect(libtime.WeekdaySetWorkdays.Complement()).To(Equal(libtime.WeekdaySetWeekend))
+		Expect(libtime.WeekdaySetAll.Complement().IsEmpty()).To(BeTrue())
+		Expect(libtime.WeekdaySetAll.Len()).To(Equal(7))
+	})
+	It("iterates from the first day", func() {
+		set := libtime.WeekdaySetWeekend.Add(libtime.Monday)
+		Expect(set.WeekdaysFrom(libtime.Monday)).To(Equal(
+			libtime.Weekdays{libtime.Monday, libtime.Saturday, libtime.Sunday},
+		))
+		Expect(set.WeekdaysFrom(libtime.Saturday)).To(Equal(
+			libtime.Weekdays{libtime.Saturday, libtime.Sunday, libtime.Monday},
+		))
+		var result libtime.Weekdays
+		for weekday := range set.All(libtime.Sunday) {
+			result = append(result, weekday)
+			break
+		}
+		Expect(result).To(Equal(libtime.Weekdays{libtime.Sunday}))
+	})
+	It("converts from Weekdays", func() {
+		weekdays := libtime.Weekdays{libtime.Friday, libtime.Monday, libtime.Friday}
+		Expect(weekdays.WeekdaySet().Weekdays()).To(Equal(
+			libtime.Weekdays{libtime.Monday, libtime.Friday},
+		))
+	})
+	DescribeTable("NextMatching and PrevMatching",
+		func(set libtime.WeekdaySet, date string, expectedNext string, expectedPrev string) {
+			next, ok := set.NextMatching(ParseDate(date))
+			Expect(ok).To(BeTrue())
+			Expect(next.String()).To(Equal(expectedNext))
+			prev, ok := set.PrevMatching(ParseDate(date))
+			Expect(ok).To(BeTrue())
+			Expect(prev.String()).To(Equal(expectedPrev))
+		},
+		// 2024-03-05 is a Tuesday
+		Entry("same day", libtime.WeekdaySetWorkdays, "2024-03-05", "2024-03-05", "2024-03-05"),
+		Entry("weekend", libtime.WeekdaySetWeekend, "2024-03-05", "2024-03-09", "2024-03-03"),
+		Entry(
+			"single day",
+			libtime.NewWeekdaySet(libtime.Monday),
+			"2024-03-05",
+			"2024-03-11",
+			"2024-03-04",
+		),
+	)
+	It("returns false for the empty set", func() {
+		_, ok := libtime.WeekdaySet(0).NextMatching(ParseDate("2024-03-05"))
+		Expect(ok).To(BeFalse())
+		_, ok = libtime.WeekdaySet(0).PrevMatching(ParseDate("2024-03-05"))
+		Expect(ok).To(BeFalse())
+	})
+	It("validates", func() {
+		Expect(libtime.WeekdaySetAll.Validate(ctx)).To(BeNil())
+		Expect(libtime.WeekdaySet(255).Validate(ctx)).NotTo(BeNil())
+	})
+	It("parses", func() {
+		set, err := libtime.ParseWeekdaySet(ctx, "Mon-Fri")
+		Expect(err).To(BeNil())
+		Expect(*set).To(Equal(libtime.WeekdaySetWorkdays))
+		Expect(set.String()).To(Equal("Monday,Tuesday,Wednesday,Thursday,Friday"))
+		_, err = libtime.ParseWeekdaySet(ctx, []int{1, 9})
+		Expect(err).NotTo(BeNil())
+	})
+	Context("JSON", func() {
+		It("round trips", func() {
+			bytes, err := json.Marshal(libtime.WeekdaySetWeekend)
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal(`[0,6]`))
+			var result libtime.WeekdaySet
+			Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result).To(Equal(libtime.WeekdaySetWeekend))
+		})
+		It("writes numbers like Weekdays", func() {
+			set, err := json.Marshal(libtime.WeekdaySetWeekend)
+			Expect(err).To(BeNil())
+			weekdays, err := json.Marshal(libtime.WeekdaySetWeekend.Weekdays())
+			Expect(err).To(BeNil())
+			Expect(string(set)).To(Equal(string(weekdays)))
+		})
+		It("writes names with Names", func() {
+			bytes, err := json.Marshal(libtime.WeekdaySetWeekend.Names())
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal(`["Sunday","Saturday"]`))
+			var result libtime.WeekdaySet
+			Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result).To(Equal(libtime.WeekdaySetWeekend))
+		})
+		DescribeTable("Unmarshal",
+			func(input string, expected libtime.WeekdaySet, expectedError bool) {
+				var result libtime.WeekdaySet
+				err := json.Unmarshal([]byte(input), &result)
+				if expectedError {
+					Expect(err).NotTo(BeNil())
+					return
+				}
+				Expect(err).To(BeNil())
+				Expect(result).To(Equal(expected))
+			},
+			Entry("numbers", `[1,5]`, libtime.NewWeekdaySet(libtime.Monday, libtime.Friday), false),
+			Entry("range", `"Sat-Sun"`, libtime.WeekdaySetWeekend, false),
+			Entry("null", `null`, libtime.WeekdaySet(0), false),
+			Entry("empty", `[]`, libtime.WeekdaySet(0), false),
+			Entry("invalid", `["Funday"]`, libtime.WeekdaySet(0), true),
+			Entry("out of range", `[9]`, libtime.WeekdaySet(0), true),
+		)
+	})
+	Context("YAML", func() {
+		type TestStruct struct {
+			Days libtime.WeekdaySet `yaml:"days"`
+		}
+		It("round trips", func() {
+			bytes, err := yaml.Marshal(TestStruct{Days: libtime.WeekdaySetWeekend})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal("days:\n    - 0\n    - 6\n"))
+			var result TestStruct
+			Expect(yaml.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result.Days).To(Equal(libtime.WeekdaySetWeekend))
+		})
+		It("accepts ranges", func() {
+			var result TestStruct
+			Expect(yaml.Unmarshal([]byte("days: Mon-Fri"), &result)).To(Succeed())
+			Expect(result.Days).To(Equal(libtime.WeekdaySetWorkdays))
+		})
+	})
+})
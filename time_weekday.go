@@ -6,12 +6,17 @@ package time
 
 import (
 	"context"
+	"encoding"
+	"encoding/json"
+	"strconv"
+	"strings"
 	stdtime "time"
 
 	"github.com/bborbe/collection"
 	"github.com/bborbe/errors"
 	"github.com/bborbe/parse"
 	"github.com/bborbe/validation"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -34,12 +39,110 @@ var AvailableWeekdays = Weekdays{
 	Saturday,
 }
 
+// weekdayNames maps lower case English names, abbreviations and RRULE codes to weekdays.
+var weekdayNames = map[string]Weekday{
+	"sunday":    Sunday,
+	"sun":       Sunday,
+	"su":        Sunday,
+	"monday":    Monday,
+	"mon":       Monday,
+	"mo":        Monday,
+	"tuesday":   Tuesday,
+	"tue":       Tuesday,
+	"tues":      Tuesday,
+	"tu":        Tuesday,
+	"wednesday": Wednesday,
+	"wed":       Wednesday,
+	"we":        Wednesday,
+	"thursday":  Thursday,
+	"thu":       Thursday,
+	"thur":      Thursday,
+	"thurs":     Thursday,
+	"th":        Thursday,
+	"friday":    Friday,
+	"fri":       Friday,
+	"fr":        Friday,
+	"saturday":  Saturday,
+	"sat":       Saturday,
+	"sa":        Saturday,
+}
+
+// ParseWeekdays parses a list of weekdays. Strings are split by comma and every item
+// is parsed with ParseWeekday. Ranges like "Mon-Fri" include both ends and may wrap
+// around the week like "Fri-Mon".
 func ParseWeekdays(ctx context.Context, values any) (Weekdays, error) {
-	ints, err := parse.ParseIntArray(ctx, values)
+	var items []any
+	switch v := values.(type) {
+	case Weekdays:
+		return v, nil
+	case []Weekday:
+		return v, nil
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return Weekdays{}, nil
+		}
+		for _, item := range strings.Split(v, ",") {
+			items = append(items, item)
+		}
+	case []string:
+		for _, item := range v {
+			items = append(items, item)
+		}
+	case []any:
+		items = v
+	default:
+		ints, err := parse.ParseIntArray(ctx, values)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse values failed")
+		}
+		return AsWeekdays(ints), nil
+	}
+	result := Weekdays{}
+	for _, item := range items {
+		weekdays, err := parseWeekdayRange(ctx, item)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse values failed")
+		}
+		result = append(result, weekdays...)
+	}
+	return result, nil
+}
+
+// parseWeekdayRange parses a single weekday or a range like "Mon-Fri".
+func parseWeekdayRange(ctx context.Context, value any) (Weekdays, error) {
+	str, ok := value.(string)
+	if !ok {
+		weekday, err := ParseWeekday(ctx, value)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse weekday failed")
+		}
+		return Weekdays{*weekday}, nil
+	}
+	fromStr, untilStr, isRange := strings.Cut(strings.TrimSpace(str), "-")
+	if !isRange {
+		weekday, err := ParseWeekday(ctx, str)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse weekday failed")
+		}
+		return Weekdays{*weekday}, nil
+	}
+	from, err := ParseWeekday(ctx, fromStr)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse range start '%s' failed", str)
+	}
+	until, err := ParseWeekday(ctx, untilStr)
 	if err != nil {
-		return nil, errors.Wrapf(ctx, err, "parse values failed")
+		return nil, errors.Wrapf(ctx, err, "parse range end '%s' failed", str)
 	}
-	return AsWeekdays(ints), nil
+	if err := (validation.All{from, until}).Validate(ctx); err != nil {
+		return nil, errors.Wrapf(ctx, err, "invalid range '%s'", str)
+	}
+	result := Weekdays{*from}
+	for weekday := *from; weekday != *until; {
+		weekday = (weekday + 1) % 7
+		result = append(result, weekday)
+	}
+	return result, nil
 }
 
 func AsWeekdays[T ~int](values []T) Weekdays {
@@ -73,12 +176,33 @@ func (w Weekdays) Contains(value Weekday) bool {
 	return collection.Contains(w, value)
 }
 
+// ParseWeekday parses a weekday from a number or a name. Numbers follow time.Weekday
+// (0=Sunday) and ISO 8601 (7=Sunday), so 1 to 6 are Monday to Saturday in both.
+// Names are English full names, abbreviations like "Mon" or RRULE codes like "MO",
+// ignoring case.
 func ParseWeekday(ctx context.Context, value any) (*Weekday, error) {
+	switch v := value.(type) {
+	case Weekday:
+		return v.Ptr(), nil
+	case stdtime.Weekday:
+		return Weekday(v).Ptr(), nil
+	}
 	i, err := parse.ParseInt(ctx, value)
+	if err == nil {
+		if i == 7 {
+			return Sunday.Ptr(), nil
+		}
+		return AsWeekday(i).Ptr(), nil
+	}
+	str, err := parse.ParseString(ctx, value)
 	if err != nil {
 		return nil, errors.Wrapf(ctx, err, "parse value failed")
 	}
-	return AsWeekday(i).Ptr(), nil
+	weekday, ok := weekdayNames[strings.ToLower(strings.TrimSpace(str))]
+	if !ok {
+		return nil, errors.Wrapf(ctx, validation.Error, "unknown weekday '%s'", str)
+	}
+	return weekday.Ptr(), nil
 }
 
 func AsWeekday[T ~int](value T) Weekday {
@@ -87,6 +211,18 @@ func AsWeekday[T ~int](value T) Weekday {
 
 type Weekday stdtime.Weekday
 
+var _ encoding.TextMarshaler = Weekday(0)
+
+var _ encoding.TextUnmarshaler = (*Weekday)(nil)
+
+var _ json.Marshaler = Weekday(0)
+
+var _ json.Unmarshaler = (*Weekday)(nil)
+
+var _ yaml.Marshaler = Weekday(0)
+
+var _ yaml.Unmarshaler = (*Weekday)(nil)
+
 func (w Weekday) Validate(ctx context.Context) error {
 	if AvailableWeekdays.Contains(w) == false {
 		return errors.Wrapf(ctx, validation.Error, "Weekdays contains invalid value")
@@ -105,3 +241,63 @@ func (w Weekday) Weekday() stdtime.Weekday {
 func (w Weekday) Ptr() *Weekday {
 	return &w
 }
+
+// MarshalText writes the number like "1" for Monday. Use WeekdayName to write names.
+func (w Weekday) MarshalText() ([]byte, error) {
+	return []byte(strconv.Itoa(int(w))), nil
+}
+
+// UnmarshalText accepts the same values as ParseWeekday.
+// Empty text results in Sunday, the zero Weekday.
+func (w *Weekday) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*w = Sunday
+		return nil
+	}
+	result, err := ParseWeekday(context.Background(), string(b))
+	if err != nil {
+		return errors.Wrapf(context.Background(), err, "parse weekday failed")
+	}
+	*w = *result
+	return nil
+}
+
+// MarshalJSON writes the number like 1 for Monday. Use WeekdayName to write names.
+func (w Weekday) MarshalJSON() ([]byte, error) {
+	return w.MarshalText()
+}
+
+// UnmarshalJSON accepts names and numbers like ParseWeekday,
+// so weekdays written as numbers are still readable. Null results in Sunday.
+func (w *Weekday) UnmarshalJSON(b []byte) error {
+	str := strings.Trim(string(b), `"`)
+	if str == "null" {
+		str = ""
+	}
+	return w.UnmarshalText([]byte(str))
+}
+
+// MarshalYAML implements yaml.Marshaler and writes the number like MarshalJSON.
+func (w Weekday) MarshalYAML() (interface{}, error) {
+	return int(w), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It accepts the same values as ParseWeekday.
+// Empty values and "null" result in Sunday, the zero Weekday.
+func (w *Weekday) UnmarshalYAML(node *yaml.Node) error {
+	ctx := context.Background()
+	str, err := yamlScalar(ctx, node)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "decode yaml failed")
+	}
+	if str == "" {
+		*w = Sunday
+		return nil
+	}
+	result, err := ParseWeekday(ctx, str)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "parse '%s' at line %d failed", str, node.Line)
+	}
+	*w = *result
+	return nil
+}
@@ -6,6 +6,8 @@ package time_test
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -35,4 +37,125 @@ var _ = Describe("Weekday", func() {
 		Entry("Sunday", libtime.Sunday, false),
 		Entry("invalid", libtime.Weekday(1337), true),
 	)
+	DescribeTable("ParseWeekday",
+		func(input interface{}, expected libtime.Weekday, expectedError bool) {
+			result, err := libtime.ParseWeekday(ctx, input)
+			if expectedError {
+				Expect(err).NotTo(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(*result).To(Equal(expected))
+		},
+		Entry("int", 3, libtime.Wednesday, false),
+		Entry("zero is sunday", 0, libtime.Sunday, false),
+		Entry("iso sunday", 7, libtime.Sunday, false),
+		Entry("number string", "1", libtime.Monday, false),
+		Entry("full name", "Monday", libtime.Monday, false),
+		Entry("lower case", "friday", libtime.Friday, false),
+		Entry("upper case", "SATURDAY", libtime.Saturday, false),
+		Entry("abbreviation", "Tue", libtime.Tuesday, false),
+		Entry("long abbreviation", "thurs", libtime.Thursday, false),
+		Entry("rrule", "WE", libtime.Wednesday, false),
+		Entry("spaces", " Sun ", libtime.Sunday, false),
+		Entry("weekday", libtime.Thursday, libtime.Thursday, false),
+		Entry("std weekday", time.Friday, libtime.Friday, false),
+		Entry("unknown", "Funday", libtime.Sunday, true),
+		Entry("empty", "", libtime.Sunday, true),
+	)
+	DescribeTable("ParseWeekdays",
+		func(input interface{}, expected libtime.Weekdays, expectedError bool) {
+			result, err := libtime.ParseWeekdays(ctx, input)
+			if expectedError {
+				Expect(err).NotTo(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expected))
+		},
+		Entry("ints", []int{1, 2}, libtime.Weekdays{libtime.Monday, libtime.Tuesday}, false),
+		Entry("names", []string{"Mon", "tue"}, libtime.Weekdays{libtime.Monday, libtime.Tuesday}, false),
+		Entry(
+			"comma separated",
+			"Mon, Wed,7",
+			libtime.Weekdays{libtime.Monday, libtime.Wednesday, libtime.Sunday},
+			false,
+		),
+		Entry(
+			"range",
+			"Mon-Fri",
+			libtime.Weekdays{
+				libtime.Monday,
+				libtime.Tuesday,
+				libtime.Wednesday,
+				libtime.Thursday,
+				libtime.Friday,
+			},
+			false,
+		),
+		Entry(
+			"wrapping range",
+			[]string{"Fri-Mon"},
+			libtime.Weekdays{libtime.Friday, libtime.Saturday, libtime.Sunday, libtime.Monday},
+			false,
+		),
+		Entry(
+			"iso range",
+			"6-7",
+			libtime.Weekdays{libtime.Saturday, libtime.Sunday},
+			false,
+		),
+		Entry(
+			"mixed",
+			[]interface{}{"Sat-Sun", 3},
+			libtime.Weekdays{libtime.Saturday, libtime.Sunday, libtime.Wednesday},
+			false,
+		),
+		Entry("single day range", "Wed-Wed", libtime.Weekdays{libtime.Wednesday}, false),
+		Entry("empty", "", libtime.Weekdays{}, false),
+		Entry("invalid name", "Mon,Funday", nil, true),
+		Entry("invalid range", "Mon-9", nil, true),
+		Entry("open range", "Mon-", nil, true),
+	)
+	Context("JSON", func() {
+		It("marshals numbers", func() {
+			bytes, err := json.Marshal(libtime.Weekdays{libtime.Monday, libtime.Sunday})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal(`[1,0]`))
+		})
+		It("marshals map keys as numbers", func() {
+			bytes, err := json.Marshal(map[libtime.Weekday]int{libtime.Monday: 1})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal(`{"1":1}`))
+			var result map[libtime.Weekday]int
+			Expect(json.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result).To(HaveKeyWithValue(libtime.Monday, 1))
+		})
+		It("unmarshals names and numbers", func() {
+			var result libtime.Weekdays
+			Expect(json.Unmarshal([]byte(`["Mon","TU",3,"4",7,null]`), &result)).To(Succeed())
+			Expect(result).To(Equal(libtime.Weekdays{
+				libtime.Monday,
+				libtime.Tuesday,
+				libtime.Wednesday,
+				libtime.Thursday,
+				libtime.Sunday,
+				libtime.Sunday,
+			}))
+		})
+		It("fails on unknown names", func() {
+			var result libtime.Weekday
+			Expect(json.Unmarshal([]byte(`"Funday"`), &result)).NotTo(Succeed())
+		})
+	})
+	Context("Text", func() {
+		It("round trips", func() {
+			text, err := libtime.Friday.MarshalText()
+			Expect(err).To(BeNil())
+			Expect(string(text)).To(Equal("5"))
+			var result libtime.Weekday
+			Expect(result.UnmarshalText([]byte("fr"))).To(Succeed())
+			Expect(result).To(Equal(libtime.Friday))
+		})
+	})
 })
@@ -0,0 +1,67 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time
+
+import (
+	"context"
+	"encoding"
+
+	"github.com/bborbe/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// yamlRange is the YAML representation of all range types.
+type yamlRange[T any] struct {
+	From  T `yaml:"from,omitempty"`
+	Until T `yaml:"until,omitempty"`
+}
+
+// yamlScalar returns the value of a scalar node. "null" returns an empty string,
+// which the types treat as zero like in UnmarshalJSON. yaml.v3 skips UnmarshalYAML
+// for null nodes and keeps the current value.
+func yamlScalar(ctx context.Context, node *yaml.Node) (string, error) {
+	if node.Kind != yaml.ScalarNode {
+		return "", errors.Errorf(ctx, "expected yaml scalar at line %d", node.Line)
+	}
+	if node.ShortTag() == "!!null" || node.Value == "null" {
+		return "", nil
+	}
+	return node.Value, nil
+}
+
+// marshalYAMLText returns the text of value as string or nil for empty text,
+// so zero values are written as null like in MarshalJSON.
+func marshalYAMLText(value encoding.TextMarshaler) (interface{}, error) {
+	b, err := value.MarshalText()
+	if err != nil {
+		return nil, errors.Wrapf(context.Background(), err, "marshal text failed")
+	}
+	if len(b) == 0 {
+		return nil, nil
+	}
+	return string(b), nil
+}
+
+// unmarshalYAMLText decodes a scalar node with UnmarshalText.
+func unmarshalYAMLText(node *yaml.Node, value encoding.TextUnmarshaler) error {
+	ctx := context.Background()
+	str, err := yamlScalar(ctx, node)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "decode yaml failed")
+	}
+	if err := value.UnmarshalText([]byte(str)); err != nil {
+		return errors.Wrapf(ctx, err, "unmarshal '%s' at line %d failed", str, node.Line)
+	}
+	return nil
+}
+
+// unmarshalYAMLRange decodes a mapping node with from and until.
+func unmarshalYAMLRange[T any](node *yaml.Node) (*yamlRange[T], error) {
+	var result yamlRange[T]
+	if err := node.Decode(&result); err != nil {
+		return nil, errors.Wrapf(context.Background(), err, "decode range failed")
+	}
+	return &result, nil
+}
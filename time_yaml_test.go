@@ -0,0 +1,249 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+
+	libtime "github.com/bborbe/time"
+)
+
+var _ = Describe("YAML", func() {
+	var now time.Time
+	var originalNow func() time.Time
+	BeforeEach(func() {
+		now = time.Date(2024, time.March, 5, 14, 7, 9, 0, time.UTC)
+		originalNow = libtime.Now
+		libtime.Now = func() time.Time { return now }
+	})
+	AfterEach(func() {
+		libtime.Now = originalNow
+	})
+	Context("DateTime", func() {
+		type TestStruct struct {
+			Value libtime.DateTime  `yaml:"value"`
+			Ptr   *libtime.DateTime `yaml:"ptr"`
+		}
+		DescribeTable("Unmarshal",
+			func(input string, expected string) {
+				var result TestStruct
+				Expect(yaml.Unmarshal([]byte(input), &result)).To(Succeed())
+				Expect(result.Value.UTC().String()).To(Equal(expected))
+			},
+			Entry("unquoted timestamp", "value: 2023-06-19T07:56:34Z", "2023-06-19T07:56:34Z"),
+			Entry("quoted timestamp", `value: "2023-06-19T07:56:34Z"`, "2023-06-19T07:56:34Z"),
+			Entry("date", "value: 2023-06-19", "2023-06-19T00:00:00Z"),
+			Entry("null", "value: null", "0001-01-01T00:00:00Z"),
+			Entry("null string", `value: "null"`, "0001-01-01T00:00:00Z"),
+			Entry("tilde", "value: ~", "0001-01-01T00:00:00Z"),
+			Entry("empty", `value: ""`, "0001-01-01T00:00:00Z"),
+			Entry("now", "value: NOW", "2024-03-05T14:07:09Z"),
+			Entry("now minus", "value: NOW-1d", "2024-03-04T14:07:09Z"),
+		)
+		It("marshals zero as null", func() {
+			bytes, err := yaml.Marshal(TestStruct{})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal("value: null\nptr: null\n"))
+		})
+		It("fails on invalid values", func() {
+			var result TestStruct
+			Expect(yaml.Unmarshal([]byte("value: banana"), &result)).NotTo(Succeed())
+			Expect(yaml.Unmarshal([]byte("value: [1, 2]"), &result)).NotTo(Succeed())
+		})
+	})
+	Context("Date", func() {
+		It("round trips", func() {
+			type TestStruct struct {
+				Value libtime.Date `yaml:"value"`
+			}
+			var result TestStruct
+			Expect(yaml.Unmarshal([]byte("value: 2023-06-19"), &result)).To(Succeed())
+			Expect(result.Value.String()).To(Equal("2023-06-19"))
+			bytes, err := yaml.Marshal(result)
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(MatchRegexp(`value: "?2023-06-19"?`))
+			Expect(yaml.Unmarshal([]byte("value: NOW+1d"), &result)).To(Succeed())
+			Expect(result.Value.String()).To(Equal("2024-03-06"))
+		})
+	})
+	Context("DateOrDateTime", func() {
+		It("keeps dates as dates", func() {
+			type TestStruct struct {
+				Value libtime.DateOrDateTime `yaml:"value"`
+			}
+			var result TestStruct
+			Expect(yaml.Unmarshal([]byte("value: 2023-06-19"), &result)).To(Succeed())
+			bytes, err := yaml.Marshal(result)
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(MatchRegexp(`value: "?2023-06-19"?\n`))
+		})
+	})
+	Context("UnixTime", func() {
+		type TestStruct struct {
+			Seconds libtime.UnixTime      `yaml:"seconds"`
+			Milli   libtime.UnixMilliTime `yaml:"milli"`
+		}
+		It("accepts epoch numbers and times", func() {
+			var result TestStruct
+			Expect(yaml.Unmarshal([]byte("seconds: 1687161394\nmilli: 1687161394123"), &result)).
+				To(Succeed())
+			Expect(result.Seconds.UTC().String()).To(Equal("2023-06-19T07:56:34Z"))
+			Expect(result.Milli.UTC().String()).To(Equal("2023-06-19T07:56:34.123Z"))
+			Expect(yaml.Unmarshal([]byte("seconds: 2023-06-19T07:56:34Z\nmilli: NOW"), &result)).
+				To(Succeed())
+			Expect(result.Seconds.UTC().String()).To(Equal("2023-06-19T07:56:34Z"))
+			Expect(result.Milli.UTC().String()).To(Equal("2024-03-05T14:07:09Z"))
+		})
+		It("handles null", func() {
+			var result TestStruct
+			Expect(yaml.Unmarshal([]byte("seconds: null\nmilli: \"null\""), &result)).To(Succeed())
+			Expect(result.Seconds.IsZero()).To(BeTrue())
+			Expect(result.Milli.IsZero()).To(BeTrue())
+			bytes, err := yaml.Marshal(TestStruct{})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal("seconds: null\nmilli: null\n"))
+		})
+	})
+	Context("TimeOfDay", func() {
+		It("unmarshals times with location", func() {
+			type TestStruct struct {
+				Value libtime.TimeOfDay `yaml:"value"`
+			}
+			var result TestStruct
+			Expect(yaml.Unmarshal([]byte("value: 09:30 Europe/Berlin"), &result)).To(Succeed())
+			Expect(result.Value.Hour).To(Equal(9))
+			Expect(result.Value.Minute).To(Equal(30))
+			Expect(result.Value.Location.String()).To(Equal("Europe/Berlin"))
+		})
+		It("marshals zero as null", func() {
+			type TestStruct struct {
+				Value libtime.TimeOfDay  `yaml:"value"`
+				Ptr   *libtime.TimeOfDay `yaml:"ptr"`
+			}
+			bytes, err := yaml.Marshal(TestStruct{Ptr: &libtime.TimeOfDay{}})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal("value: null\nptr: null\n"))
+			var result TestStruct
+			Expect(yaml.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result.Value).To(Equal(libtime.TimeOfDay{}))
+		})
+		It("round trips", func() {
+			type TestStruct struct {
+				Value libtime.TimeOfDay `yaml:"value"`
+			}
+			original := TestStruct{Value: libtime.TimeOfDay{Hour: 9, Minute: 30, Location: time.UTC}}
+			bytes, err := yaml.Marshal(original)
+			Expect(err).To(BeNil())
+			var result TestStruct
+			Expect(yaml.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result).To(Equal(original))
+		})
+		It("formats a nil location as UTC", func() {
+			Expect(libtime.TimeOfDay{Hour: 9}.String()).To(Equal("09:00:00Z"))
+		})
+	})
+	Context("Duration", func() {
+		type TestStruct struct {
+			Value libtime.Duration `yaml:"value"`
+		}
+		DescribeTable("Unmarshal",
+			func(input string, expected libtime.Duration) {
+				var result TestStruct
+				Expect(yaml.Unmarshal([]byte(input), &result)).To(Succeed())
+				Expect(result.Value).To(Equal(expected))
+			},
+			Entry("minutes", "value: 5m", 5*libtime.Minute),
+			Entry("days", "value: 1d", libtime.Day),
+			Entry("go format", "value: 1h30m0s", 90*libtime.Minute),
+			Entry("quoted", `value: "5m"`, 5*libtime.Minute),
+			Entry("nanoseconds", "value: 300", libtime.Duration(300)),
+			Entry("negative", "value: -5m", -5*libtime.Minute),
+			Entry("null", "value: null", libtime.Duration(0)),
+			Entry("empty", `value: ""`, libtime.Duration(0)),
+		)
+		It("marshals the Go duration format", func() {
+			bytes, err := yaml.Marshal(TestStruct{Value: 5 * libtime.Minute})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal("value: 5m0s\n"))
+			bytes, err = yaml.Marshal(TestStruct{})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal("value: 0s\n"))
+		})
+		It("fails on invalid values", func() {
+			var result TestStruct
+			Expect(yaml.Unmarshal([]byte("value: 5 minutes"), &result)).NotTo(Succeed())
+		})
+	})
+	Context("Weekday", func() {
+		type TestStruct struct {
+			Value libtime.Weekday `yaml:"value"`
+		}
+		It("round trips", func() {
+			bytes, err := yaml.Marshal(TestStruct{Value: libtime.Wednesday})
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal("value: 3\n"))
+			var result TestStruct
+			Expect(yaml.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result.Value).To(Equal(libtime.Wednesday))
+		})
+		It("handles null", func() {
+			var result TestStruct
+			Expect(yaml.Unmarshal([]byte(`value: "null"`), &result)).To(Succeed())
+			Expect(result.Value).To(Equal(libtime.Sunday))
+		})
+	})
+	Context("ranges", func() {
+		It("round trips DateTimeRange", func() {
+			input := libtime.DayDateTimeRange(libtime.DateTime(now))
+			bytes, err := yaml.Marshal(input)
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(MatchRegexp(
+				`^from: "?2024-03-05T00:00:00Z"?\nuntil: "?2024-03-05T23:59:59.999999999Z"?\n$`,
+			))
+			var result libtime.DateTimeRange
+			Expect(yaml.Unmarshal(bytes, &result)).To(Succeed())
+			Expect(result.From.Equal(input.From)).To(BeTrue())
+			Expect(result.Until.Equal(input.Until)).To(BeTrue())
+		})
+		It("accepts NOW expressions and omits zero sides", func() {
+			var result libtime.DateRange
+			Expect(yaml.Unmarshal([]byte("from: NOW-7d\n"), &result)).To(Succeed())
+			Expect(result.From.String()).To(Equal("2024-02-27"))
+			Expect(result.Until.IsZero()).To(BeTrue())
+			bytes, err := yaml.Marshal(result)
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(MatchRegexp(`^from: "?2024-02-27"?\n$`))
+		})
+		It("unmarshals TimeRange with NOW", func() {
+			var result libtime.TimeRange
+			Expect(yaml.Unmarshal([]byte("from: NOW-1h\nuntil: NOW"), &result)).To(Succeed())
+			Expect(result.From).To(Equal(now.Add(-time.Hour)))
+			Expect(result.Until).To(Equal(now))
+		})
+		It("unmarshals unix time ranges from epoch numbers", func() {
+			var result libtime.UnixMilliTimeRange
+			Expect(yaml.Unmarshal([]byte("from: 1687161394123\nuntil: 1687161395123"), &result)).
+				To(Succeed())
+			Expect(result.Until.Sub(result.From)).To(Equal(libtime.Second))
+			var seconds libtime.UnixTimeRange
+			Expect(yaml.Unmarshal([]byte("from: 1687161394"), &seconds)).To(Succeed())
+			Expect(seconds.From.Unix()).To(Equal(int64(1687161394)))
+		})
+		It("handles null", func() {
+			type TestStruct struct {
+				Range    libtime.DateTimeRange  `yaml:"range"`
+				RangePtr *libtime.DateTimeRange `yaml:"rangePtr"`
+			}
+			var result TestStruct
+			Expect(yaml.Unmarshal([]byte("range: null\nrangePtr: null"), &result)).To(Succeed())
+			Expect(result.Range).To(Equal(libtime.DateTimeRange{}))
+			Expect(result.RangePtr).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tzdata embeds a pinned copy of the IANA time zone database.
+//
+// Importing the package for its side effects makes libtime.LoadLocation use the embedded
+// database instead of the zoneinfo of the host:
+//
+//	import _ "github.com/bborbe/time/tzdata"
+//
+// The binary grows by about 400 KB. To update, copy $GOROOT/lib/time/zoneinfo.zip
+// of a Go release to zoneinfo.zip and set Version to its tzdata version.
+package tzdata
+
+import (
+	"context"
+	_ "embed"
+
+	libtime "github.com/bborbe/time"
+)
+
+// Version is the IANA tzdata version of the embedded database.
+const Version = "2026c"
+
+//go:embed zoneinfo.zip
+var zoneinfo []byte
+
+var source libtime.LocationSource
+
+func init() {
+	var err error
+	source, err = libtime.NewZipDataLocationSource(
+		context.Background(),
+		"tzdata:"+Version,
+		Version,
+		zoneinfo,
+	)
+	if err != nil {
+		panic(err)
+	}
+	libtime.SetLocationSource(source)
+}
+
+// TZDataVersion returns the IANA tzdata version of the embedded database.
+func TZDataVersion() string {
+	return Version
+}
+
+// Source returns the location source of the embedded database.
+func Source() libtime.LocationSource {
+	return source
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tzdata_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	libtime "github.com/bborbe/time"
+	"github.com/bborbe/time/tzdata"
+)
+
+var _ = Describe("TZData", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	It("returns the pinned version", func() {
+		Expect(tzdata.TZDataVersion()).To(Equal(tzdata.Version))
+		Expect(tzdata.Source().Version()).To(Equal(tzdata.Version))
+	})
+	It("registers the embedded source on import", func() {
+		Expect(libtime.GetLocationSource().Key()).To(Equal(tzdata.Source().Key()))
+		Expect(libtime.TZDataVersion()).To(Equal(tzdata.Version))
+	})
+	DescribeTable("LoadLocation",
+		func(name string, expectedName string, expectedOffset libtime.Duration) {
+			location, err := libtime.LoadLocation(ctx, name)
+			Expect(err).To(BeNil())
+			Expect(location.String()).To(Equal(expectedName))
+			july := libtime.DateTime(time.Date(2024, time.July, 1, 12, 0, 0, 0, time.UTC))
+			Expect(libtime.OffsetAt(location, july)).To(Equal(expectedOffset))
+		},
+		Entry("UTC", "UTC", "UTC", libtime.Duration(0)),
+		Entry("Europe/Berlin", "Europe/Berlin", "Europe/Berlin", 2*libtime.Hour),
+		Entry("America/New_York", "America/New_York", "America/New_York", -4*libtime.Hour),
+		Entry("Windows id", "W. Europe Standard Time", "Europe/Berlin", 2*libtime.Hour),
+		Entry("deprecated link", "Europe/Kiev", "Europe/Kiev", 3*libtime.Hour),
+	)
+	It("returns an error for unknown locations", func() {
+		_, err := libtime.LoadLocation(ctx, "Banana")
+		Expect(err).NotTo(BeNil())
+	})
+})